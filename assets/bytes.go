@@ -0,0 +1,31 @@
+package assets
+
+import (
+	"io"
+	"strings"
+)
+
+// Bytes returns the contents of an asset the same way the handler serves
+// it, which is useful to inline assets like critical CSS when rendering.
+// The name can be the logical one, e.g. "main.css", or a path returned
+// by PathFor. In development the processed file in the output folder is
+// returned, falling back to the embedded one.
+func (m *manager) Bytes(name string) ([]byte, error) {
+	// Versioned paths carry the version in the query.
+	name, _, _ = strings.Cut(name, "?")
+	name = m.normalize(name)
+
+	file, err := m.Open(name)
+	if err != nil {
+		// The fingerprint may not be cached, e.g. after a restart.
+		file, err = m.Open(m.normalize(m.LogicalName(m.withPrefix(name))))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
@@ -0,0 +1,60 @@
+package assets_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+)
+
+func TestBytes(t *testing.T) {
+	t.Setenv("GO_ENV", "development")
+
+	// The output folder holds the processed version of the files.
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.css"), []byte("body{color:red}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := assets.NewManager(fstest.MapFS{
+		"main.css":       {Data: []byte("body {\n  color: red;\n}\n")},
+		"vendor/htmx.js": {Data: []byte("HTMX")},
+	}, assets.WithOutputFolder(dir))
+
+	fingerprinted, err := m.PathFor("main.css")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcases := []struct {
+		name     string
+		expected string
+	}{
+		{"main.css", "body{color:red}"},
+		{"/public/main.css", "body{color:red}"},
+		{fingerprinted, "body{color:red}"},
+		{"/public/main-e1faffb3e614e6c2fba74296962386b7.css", "body{color:red}"},
+		{"vendor/htmx.js", "HTMX"},
+	}
+
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			bb, err := m.Bytes(tcase.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(bb) != tcase.expected {
+				t.Errorf("Expected %q, got %q", tcase.expected, string(bb))
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := m.Bytes("missing.css"); err == nil {
+			t.Error("Expected an error for a missing file")
+		}
+	})
+}
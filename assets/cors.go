@@ -0,0 +1,51 @@
+package assets
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// cors sets the CORS headers for requests from the origins allowed
+// with WithCORS and responds to the preflight requests. It returns
+// whether the request was handled.
+func (m *manager) cors(w http.ResponseWriter, r *http.Request) bool {
+	if len(m.corsOrigins) == 0 {
+		return false
+	}
+
+	origin := r.Header.Get("Origin")
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+	w.Header().Add("Vary", "Origin")
+	if preflight {
+		w.Header().Add("Vary", "Access-Control-Request-Method")
+		w.Header().Add("Vary", "Access-Control-Request-Headers")
+	}
+
+	allowed := origin != "" && (slices.Contains(m.corsOrigins, "*") || slices.ContainsFunc(m.corsOrigins, func(o string) bool {
+		return strings.EqualFold(o, origin)
+	}))
+
+	if allowed {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+
+	if !preflight {
+		return false
+	}
+
+	// Disallowed preflights are answered without the CORS
+	// headers so browsers block the actual request.
+	if allowed {
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+		if headers := r.Header.Get("Access-Control-Request-Headers"); headers != "" {
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+		}
+
+		w.Header().Set("Access-Control-Max-Age", "86400")
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
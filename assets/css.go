@@ -0,0 +1,132 @@
+package assets
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// cssURLExp matches the url() references in stylesheets,
+// capturing the quoted or unquoted reference.
+var cssURLExp = regexp.MustCompile(`url\(\s*(?:'([^']*)'|"([^"]*)"|([^'")\s]*))\s*\)`)
+
+// rewriteCSS replaces the url() references in the stylesheet with the
+// fingerprinted paths of the referenced assets. References are resolved
+// relative to the stylesheet, external URLs, data URIs, stylesheets and
+// assets that don't exist are left as they are.
+func (m *manager) rewriteCSS(name string, css []byte) []byte {
+	dir := path.Dir(m.normalize(name))
+
+	return cssURLExp.ReplaceAllFunc(css, func(match []byte) []byte {
+		groups := cssURLExp.FindSubmatch(match)
+
+		quote, ref := "", string(groups[3])
+		switch {
+		case groups[1] != nil:
+			quote, ref = "'", string(groups[1])
+		case groups[2] != nil:
+			quote, ref = `"`, string(groups[2])
+		}
+
+		// Query strings and fragments, like in font URLs, are kept.
+		target, suffix := ref, ""
+		if i := strings.IndexAny(ref, "?#"); i >= 0 {
+			target, suffix = ref[:i], ref[i:]
+		}
+
+		if target == "" || strings.Contains(target, ":") || strings.HasPrefix(target, "//") || path.Ext(target) == ".css" {
+			return match
+		}
+
+		if strings.HasPrefix(target, "/") {
+			if !strings.HasPrefix(target, m.handlerPrefix()) {
+				return match
+			}
+
+			target = m.normalize(target)
+		} else {
+			target = path.Join(dir, target)
+		}
+
+		fingerprinted, err := m.PathFor(target)
+		if err != nil {
+			return match
+		}
+
+		return []byte("url(" + quote + withSuffix(fingerprinted, suffix) + quote + ")")
+	})
+}
+
+// withSuffix appends the query string and fragment of a reference to
+// the path. When the path already has a query, like the versioned ones,
+// the query of the reference is merged into it.
+func withSuffix(p, suffix string) string {
+	if !strings.Contains(p, "?") || !strings.HasPrefix(suffix, "?") {
+		return p + suffix
+	}
+
+	query, fragment, _ := strings.Cut(suffix[1:], "#")
+	if query != "" {
+		p += "&" + query
+	}
+
+	if fragment != "" {
+		p += "#" + fragment
+	}
+
+	return p
+}
+
+// rewrittenFile is a file served with contents other than the
+// ones in the file system, it reports the size of the new contents.
+type rewrittenFile struct {
+	fs.File
+	reader *bytes.Reader
+}
+
+func newRewrittenFile(file fs.File, contents []byte) *rewrittenFile {
+	return &rewrittenFile{
+		File:   file,
+		reader: bytes.NewReader(contents),
+	}
+}
+
+func (f *rewrittenFile) Read(p []byte) (int, error) {
+	return f.reader.Read(p)
+}
+
+func (f *rewrittenFile) Seek(offset int64, whence int) (int64, error) {
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *rewrittenFile) Stat() (fs.FileInfo, error) {
+	info, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return rewrittenInfo{FileInfo: info, size: f.reader.Size()}, nil
+}
+
+type rewrittenInfo struct {
+	fs.FileInfo
+	size int64
+}
+
+func (i rewrittenInfo) Size() int64 {
+	return i.size
+}
+
+// openCSS opens the stylesheet with its url() references rewritten.
+func (m *manager) openCSS(name string, file fs.File) (fs.File, error) {
+	css, err := io.ReadAll(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return newRewrittenFile(file, m.rewriteCSS(name, css)), nil
+}
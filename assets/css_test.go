@@ -0,0 +1,99 @@
+package assets_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+)
+
+func TestCSSURLRewrite(t *testing.T) {
+	files := fstest.MapFS{
+		"css/app.css": {Data: []byte(
+			`.a{background:url(./logo.png)}` +
+				`.b{background:url('../images/bg.png')}` +
+				`.c{src:url("/public/fonts/a.woff2?v=1#x")}` +
+				`.d{background:url(data:image/png;base64,AAA)}` +
+				`.e{background:url(https://example.com/a.png)}` +
+				`.f{background:url(missing.png)}`,
+		)},
+		"css/logo.png":  {Data: []byte("AAA")},
+		"images/bg.png": {Data: []byte("BBB")},
+		"fonts/a.woff2": {Data: []byte("CCC")},
+	}
+
+	expected := `.a{background:url(/public/css/logo-e1faffb3e614e6c2fba74296962386b7.png)}` +
+		`.b{background:url('/public/images/bg-2bb225f0ba9a58930757a868ed57d9a3.png')}` +
+		`.c{src:url("/public/fonts/a-defb99e69a9f1f6e06f15006b1f166ae.woff2?v=1#x")}` +
+		`.d{background:url(data:image/png;base64,AAA)}` +
+		`.e{background:url(https://example.com/a.png)}` +
+		`.f{background:url(missing.png)}`
+
+	t.Run("rewrites references", func(t *testing.T) {
+		m := assets.NewManager(files, assets.WithCSSURLRewrite())
+
+		bb, err := m.ReadFile("css/app.css")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(bb) != expected {
+			t.Errorf("Expected %s, got %s", expected, string(bb))
+		}
+	})
+
+	t.Run("serves the rewritten stylesheet", func(t *testing.T) {
+		m := assets.NewManager(files, assets.WithCSSURLRewrite())
+
+		fingerprinted, err := m.PathFor("css/app.css")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, httptest.NewRequest(http.MethodGet, fingerprinted, nil))
+
+		if res.Body.String() != expected {
+			t.Errorf("Expected %s, got %s", expected, res.Body.String())
+		}
+
+		if res.Header().Get("Content-Length") != strconv.Itoa(len(expected)) {
+			t.Errorf("Expected Content-Length %d, got %s", len(expected), res.Header().Get("Content-Length"))
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		m := assets.NewManager(files)
+
+		bb, err := m.ReadFile("css/app.css")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(bb) != string(files["css/app.css"].Data) {
+			t.Errorf("Expected the stylesheet to be unchanged, got %s", string(bb))
+		}
+	})
+
+	t.Run("merges the query with the version", func(t *testing.T) {
+		m := assets.NewManager(fstest.MapFS{
+			"css/fonts.css": {Data: []byte(
+				`@font-face{src:url(../fonts/a.woff2?#iefix),url(../fonts/a.woff2?x=1#y),url(../fonts/a.woff2#z)}`,
+			)},
+			"fonts/a.woff2": {Data: []byte("CCC")},
+		}, assets.WithCSSURLRewrite(), assets.WithVersion("1.2.0"))
+
+		bb, err := m.ReadFile("css/fonts.css")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := `@font-face{src:url(/public/fonts/a.woff2?v=1.2.0#iefix),url(/public/fonts/a.woff2?v=1.2.0&x=1#y),url(/public/fonts/a.woff2?v=1.2.0#z)}`
+		if string(bb) != expected {
+			t.Errorf("Expected %s, got %s", expected, string(bb))
+		}
+	})
+}
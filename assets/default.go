@@ -0,0 +1,27 @@
+package assets
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// defaultManager is the manager used by the package level functions.
+var defaultManager atomic.Pointer[manager]
+
+// SetDefault sets the manager used by the package level functions,
+// typically the one the application serves its assets with.
+func SetDefault(m *manager) {
+	defaultManager.Store(m)
+}
+
+// PathFor returns the fingerprinted path for a given file using the
+// default manager. It allows code outside templates, like emails or
+// sitemaps, to link to the assets without passing the manager around.
+func PathFor(name string) (string, error) {
+	m := defaultManager.Load()
+	if m == nil {
+		return "", errors.New("assets: no default manager, use assets.SetDefault")
+	}
+
+	return m.PathFor(name)
+}
@@ -0,0 +1,41 @@
+package assets_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+)
+
+func TestDefaultPathFor(t *testing.T) {
+	t.Run("no default manager", func(t *testing.T) {
+		if _, err := assets.PathFor("main.js"); err == nil {
+			t.Error("Expected an error without a default manager")
+		}
+	})
+
+	m := assets.NewManager(fstest.MapFS{
+		"main.js": {Data: []byte("AAA")},
+	}, assets.WithServingPath("/static"))
+
+	assets.SetDefault(m)
+	t.Cleanup(func() { assets.SetDefault(nil) })
+
+	expected, err := m.PathFor("main.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := assets.PathFor("main.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	if _, err := assets.PathFor("missing.js"); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
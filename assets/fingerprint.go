@@ -2,22 +2,30 @@ package assets
 
 import (
 	"crypto/md5"
+	"crypto/sha512"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
+	"regexp"
 	"strings"
 )
 
-var normalized = func(name string) string {
-	name = strings.TrimPrefix(name, "/public/")
-	name = strings.TrimPrefix(name, "public/")
+// normalize removes the serving prefix from the
+// passed name, with or without the starting slash.
+func (m *manager) normalize(name string) string {
+	prefix := m.handlerPrefix()
+	name = strings.TrimPrefix(name, prefix)
+	name = strings.TrimPrefix(name, strings.TrimPrefix(prefix, "/"))
 
 	return name
 }
 
-var withPrefix = func(name string) string {
-	return path.Join("/public/", name)
+// withPrefix adds the serving prefix to the passed name.
+func (m *manager) withPrefix(name string) string {
+	return path.Join(m.handlerPrefix(), name)
 }
 
 // PathFor returns the fingerprinted path for a given
@@ -28,12 +36,121 @@ var withPrefix = func(name string) string {
 // filename for the map should be the file without the prefix
 // filename returned should be the file with the prefix
 func (m *manager) PathFor(fname string) (string, error) {
-	normalized := normalized(fname)
-	result := m.fileToHash[normalized]
+	normalized := m.normalize(fname)
+	if m.version != "" {
+		file, err := m.source(normalized)
+		if err != nil {
+			return "", fmt.Errorf("could not open %s: %w", normalized, os.ErrNotExist)
+		}
+
+		file.Close()
+		return m.withPrefix(normalized) + "?v=" + url.QueryEscape(m.version), nil
+	}
+
+	result := m.cached(m.fileToHash, normalized)
 	if result != "" {
-		return withPrefix(result), nil
+		return m.withPrefix(result), nil
+	}
+
+	filename, err := m.fingerprint(normalized)
+	if err != nil {
+		return "", err
+	}
+
+	return m.withPrefix(filename), nil
+}
+
+// fingerprintExp matches the hash PathFor adds before the extension.
+var fingerprintExp = regexp.MustCompile(`-[0-9a-f]{32}(\.[^./]*)?$`)
+
+// LogicalName returns the request path without the fingerprint, e.g.
+// "/public/main-<hash>.js" returns "/public/main.js". This allows logs
+// and metrics to aggregate requests per asset regardless of its version.
+// Paths outside the serving prefix are returned as they are.
+func (m *manager) LogicalName(requestPath string) string {
+	prefix := m.handlerPrefix()
+	if !strings.HasPrefix(requestPath, prefix) {
+		return requestPath
 	}
 
+	name := strings.TrimPrefix(requestPath, prefix)
+	if original := m.cached(m.HashToFile, name); original != "" {
+		return m.withPrefix(original)
+	}
+
+	// The fingerprint may not be cached, e.g. after a restart.
+	return m.withPrefix(fingerprintExp.ReplaceAllString(name, "$1"))
+}
+
+// IntegrityFor returns the subresource integrity hash of
+// a given file, to be used in the integrity attribute of
+// script and link tags, e.g. "sha384-oqVuAfXRKap7f...".
+func (m *manager) IntegrityFor(fname string) (string, error) {
+	normalized := m.normalize(fname)
+	result := m.cached(m.fileToIntegrity, normalized)
+	if result != "" {
+		return result, nil
+	}
+
+	if _, err := m.fingerprint(normalized); err != nil {
+		return "", err
+	}
+
+	return m.cached(m.fileToIntegrity, normalized), nil
+}
+
+// cached looks the key up in one of the fingerprint maps,
+// these are written by fingerprint under the same lock.
+func (m *manager) cached(values map[string]string, key string) string {
+	m.fmut.RLock()
+	defer m.fmut.RUnlock()
+
+	return values[key]
+}
+
+// Warmup computes and caches the fingerprints and integrity hashes
+// of all the assets, so the first requests don't pay the hashing
+// cost. It's meant to be called once when the application starts.
+func (m *manager) Warmup() error {
+	paths, err := m.Assets()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths {
+		if _, err := m.fingerprint(m.normalize(p)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AssetsHash returns a hash of the names and contents of all the assets,
+// which changes whenever any asset is added, removed or modified. This is
+// useful as a single version string for the whole set of assets.
+func (m *manager) AssetsHash() (string, error) {
+	paths, err := m.Assets()
+	if err != nil {
+		return "", err
+	}
+
+	hash := md5.New()
+	for _, p := range paths {
+		bb, err := m.ReadFile(m.normalize(p))
+		if err != nil {
+			return "", fmt.Errorf("could not open %s: %w", p, err)
+		}
+
+		fmt.Fprintf(hash, "%s %x\n", p, md5.Sum(bb))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// fingerprint reads the file to compute and cache its fingerprinted
+// name and integrity hash, it returns the fingerprinted name.
+func (m *manager) fingerprint(normalized string) (string, error) {
 	// Compute the hash of the file
 	bb, err := m.ReadFile(normalized)
 	if err != nil {
@@ -48,10 +165,13 @@ func (m *manager) PathFor(fname string) (string, error) {
 	filename := strings.TrimSuffix(normalized, ext)
 	filename += "-" + hashString + ext
 
+	integrity := sha512.Sum384(bb)
+
 	m.fmut.Lock()
 	defer m.fmut.Unlock()
 	m.fileToHash[normalized] = filename
 	m.HashToFile[filename] = normalized
+	m.fileToIntegrity[normalized] = "sha384-" + base64.StdEncoding.EncodeToString(integrity[:])
 
-	return withPrefix(filename), nil
+	return filename, nil
 }
@@ -1,7 +1,11 @@
 package assets_test
 
 import (
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"testing/fstest"
 
@@ -91,3 +95,273 @@ func TestFingerprint(t *testing.T) {
 		}
 	})
 }
+
+// countingFS counts the files opened from the wrapped file system.
+type countingFS struct {
+	fstest.MapFS
+	opened map[string]int
+}
+
+func (c countingFS) Open(name string) (fs.File, error) {
+	c.opened[name]++
+	return c.MapFS.Open(name)
+}
+
+func TestWarmup(t *testing.T) {
+	files := countingFS{
+		MapFS: fstest.MapFS{
+			"main.js":     {Data: []byte("AAA")},
+			"css/app.css": {Data: []byte("BBB")},
+		},
+		opened: map[string]int{},
+	}
+
+	m := assets.NewManager(files)
+	if err := m.Warmup(); err != nil {
+		t.Fatal(err)
+	}
+
+	clear(files.opened)
+
+	for _, name := range []string{"main.js", "/public/css/app.css"} {
+		if _, err := m.PathFor(name); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := m.IntegrityFor(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(files.opened) > 0 {
+		t.Errorf("Expected no files to be read after warmup, got %v", files.opened)
+	}
+}
+
+// trackingFS keeps the number of files opened and not closed yet.
+type trackingFS struct {
+	fstest.MapFS
+	open *int
+}
+
+func (t trackingFS) Open(name string) (fs.File, error) {
+	file, err := t.MapFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	*t.open++
+	return trackedFile{File: file, open: t.open}, nil
+}
+
+type trackedFile struct {
+	fs.File
+	open *int
+}
+
+func (t trackedFile) Close() error {
+	*t.open--
+	return t.File.Close()
+}
+
+func TestFilesClosed(t *testing.T) {
+	var open int
+	m := assets.NewManager(trackingFS{
+		MapFS: fstest.MapFS{
+			"main.js":     {Data: []byte("AAA")},
+			"css/app.css": {Data: []byte("BBB")},
+		},
+		open: &open,
+	})
+
+	if err := m.Warmup(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.AssetsHash(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.ReadFile("main.js"); err != nil {
+		t.Fatal(err)
+	}
+
+	if open != 0 {
+		t.Errorf("Expected all the files to be closed, got %d open", open)
+	}
+}
+
+func TestIntegrityFor(t *testing.T) {
+	m := assets.NewManager(fstest.MapFS{
+		"main.js": {Data: []byte("AAA")},
+	})
+
+	integrity, err := m.IntegrityFor("/public/main.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// echo -n AAA | openssl dgst -sha384 -binary | openssl base64 -A
+	expected := "sha384-" + "ilt8GbzRcE1SH4a5YY2G3g7Uj6KXEa1NFiMPfSazYRG+r3/v6LO+ehfODhQMoAL+"
+	if integrity != expected {
+		t.Errorf("Expected %s, got %s", expected, integrity)
+	}
+
+	if _, err := m.IntegrityFor("missing.js"); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
+
+func TestLogicalName(t *testing.T) {
+	m := assets.NewManager(fstest.MapFS{
+		"main.js":     {Data: []byte("AAA")},
+		"css/app.css": {Data: []byte("BBB")},
+	})
+
+	t.Run("fingerprinted path", func(t *testing.T) {
+		p, err := m.PathFor("css/app.css")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if name := m.LogicalName(p); name != "/public/css/app.css" {
+			t.Errorf("Expected /public/css/app.css, got %s", name)
+		}
+	})
+
+	t.Run("fingerprint not cached", func(t *testing.T) {
+		name := m.LogicalName("/public/main-e1faffb3e614e6c2fba74296962386b7.js")
+		if name != "/public/main.js" {
+			t.Errorf("Expected /public/main.js, got %s", name)
+		}
+	})
+
+	t.Run("paths without fingerprint", func(t *testing.T) {
+		for _, p := range []string{"/public/main.js", "/public/my-file.js", "/users/1"} {
+			if name := m.LogicalName(p); name != p {
+				t.Errorf("Expected %s, got %s", p, name)
+			}
+		}
+	})
+}
+
+func TestAssetsHash(t *testing.T) {
+	files := fstest.MapFS{
+		"main.js":     {Data: []byte("AAA")},
+		"css/app.css": {Data: []byte("BBB")},
+	}
+
+	hash := func() string {
+		h, err := assets.NewManager(files).AssetsHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return h
+	}
+
+	original := hash()
+	if original != hash() {
+		t.Fatal("Expected the hash to be deterministic")
+	}
+
+	files["css/app.css"] = &fstest.MapFile{Data: []byte("CCC")}
+	changed := hash()
+	if changed == original {
+		t.Error("Expected the hash to change when a file changes")
+	}
+
+	files["other.js"] = &fstest.MapFile{Data: []byte("DDD")}
+	if hash() == changed {
+		t.Error("Expected the hash to change when a file is added")
+	}
+}
+
+func TestWithVersion(t *testing.T) {
+	m := assets.NewManager(fstest.MapFS{
+		"main.js":     {Data: []byte("AAA")},
+		"css/app.css": {Data: []byte("BBB")},
+	}, assets.WithVersion("20240315"))
+
+	for name, expected := range map[string]string{
+		"main.js":             "/public/main.js?v=20240315",
+		"/public/css/app.css": "/public/css/app.css?v=20240315",
+	} {
+		a, err := m.PathFor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := m.PathFor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a != expected || a != b {
+			t.Errorf("Expected %s, got %s and %s", expected, a, b)
+		}
+	}
+
+	if _, err := m.PathFor("missing.js"); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+
+	t.Run("handler serves the versioned path", func(t *testing.T) {
+		p, err := m.PathFor("main.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, p, nil)
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, req)
+
+		if res.Body.String() != "AAA" {
+			t.Errorf("Expected body AAA, got %s", res.Body.String())
+		}
+	})
+}
+
+func TestConcurrentLookups(t *testing.T) {
+	m := assets.NewManager(fstest.MapFS{
+		"main.js":  {Data: []byte("AAA")},
+		"main.css": {Data: []byte("BBB")},
+	})
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			name := "main.js"
+			if i%2 == 0 {
+				name = "main.css"
+			}
+
+			p, err := m.PathFor(name)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			if _, err := m.IntegrityFor(name); err != nil {
+				t.Error(err)
+			}
+
+			if l := m.LogicalName(p); l != "/public/"+name {
+				t.Errorf("Expected the logical name of %s, got %s", p, l)
+			}
+
+			file, err := m.Open(strings.TrimPrefix(p, "/public/"))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			file.Close()
+		}()
+	}
+
+	wg.Wait()
+}
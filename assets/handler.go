@@ -1,10 +1,12 @@
 package assets
 
 import (
+	"bytes"
 	"io"
 	"io/fs"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 )
@@ -14,30 +16,49 @@ func (m *manager) HandlerPattern() string {
 }
 
 func (m *manager) HandlerFn(w http.ResponseWriter, r *http.Request) {
-	http.ServeFileFS(w, r, m, strings.TrimPrefix(r.URL.Path, m.handlerPrefix()))
+	if m.cors(w, r) {
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, m.handlerPrefix())
+
+	// Directories are only listed in development when enabled.
+	if !m.listDirectories() && m.isDir(name) {
+		m.notFound(w, r)
+		return
+	}
+
+	if !m.exists(name) {
+		m.notFound(w, r)
+		return
+	}
+
+	// Joining with the root allows to serve the root folder
+	// and removes the trailing slash of folder paths.
+	http.ServeFileFS(w, r, m, path.Join("/", name))
 }
 
 func (m *manager) Open(name string) (file fs.File, err error) {
-	ext := filepath.Ext(name)
-	if ext == ".go" {
+	name, ok := m.resolve(name)
+	if !ok {
 		return nil, os.ErrNotExist
 	}
 
-	// Converting hashed into original file name
-	smp := m.HashToFile[name]
-	if smp != "" {
-		name = smp
+	file, err = m.source(name)
+	if err != nil {
+		return nil, err
 	}
 
-	fn := m.embedded.Open
-	if env := os.Getenv("GO_ENV"); env == "development" {
-		fn = m.folder.Open
+	if m.rewriteCSSURLs && filepath.Ext(name) == ".css" {
+		return m.openCSS(name, file)
 	}
 
-	name = strings.TrimPrefix(name, m.handlerPrefix())
+	// Range requests need the file to be seekable.
+	if _, ok := file.(io.Seeker); ok {
+		return file, nil
+	}
 
-	file, err = fn(name)
-	return file, err
+	return newSeekableFile(file)
 }
 
 func (m *manager) ReadFile(name string) ([]byte, error) {
@@ -46,9 +67,168 @@ func (m *manager) ReadFile(name string) ([]byte, error) {
 		return nil, err
 	}
 
+	defer x.Close()
+
 	return io.ReadAll(x)
 }
 
+// HTTPFileSystem returns the manager as an http.FileSystem to be used
+// with third party handlers like http.FileServer. Files are opened
+// through the manager, so fingerprinted names are resolved and Go
+// source files are not served.
+func (m *manager) HTTPFileSystem() http.FileSystem {
+	return http.FS(m)
+}
+
+// active returns the file system files are served from, the
+// output folder in development and the embedded one otherwise.
+func (m *manager) active() fs.FS {
+	if env := os.Getenv("GO_ENV"); env == "development" {
+		return m.folder
+	}
+
+	return m.embedded
+}
+
+// listDirectories returns whether directory listings can be
+// served, these are only enabled in development.
+func (m *manager) listDirectories() bool {
+	return m.directoryListing && os.Getenv("GO_ENV") == "development"
+}
+
+// resolve returns the name of the file in the file systems for the
+// passed name, converting fingerprinted names into the original ones.
+// Go source files are never served so these are not resolved.
+func (m *manager) resolve(name string) (string, bool) {
+	if filepath.Ext(name) == ".go" {
+		return "", false
+	}
+
+	// Converting hashed into original file name
+	smp := m.cached(m.HashToFile, name)
+	if smp != "" {
+		name = smp
+	}
+
+	return strings.TrimPrefix(name, m.handlerPrefix()), true
+}
+
+// stat returns the file info of the named file from the file
+// systems, falling back to the embedded one like source does.
+func (m *manager) stat(name string) (fs.FileInfo, error) {
+	if os.Getenv("GO_ENV") != "development" {
+		return fs.Stat(m.embedded, name)
+	}
+
+	info, err := fs.Stat(m.folder, name)
+	if err == nil {
+		return info, nil
+	}
+
+	return fs.Stat(m.embedded, name)
+}
+
+// source opens the named file from the active file system. In
+// development files missing in the output folder are opened from
+// the embedded file system, e.g. vendored assets.
+func (m *manager) source(name string) (fs.File, error) {
+	if os.Getenv("GO_ENV") != "development" {
+		return m.embedded.Open(name)
+	}
+
+	file, err := m.folder.Open(name)
+	if err == nil {
+		return file, nil
+	}
+
+	return m.embedded.Open(name)
+}
+
+// isDir returns whether the passed name is a directory
+// in the active file system.
+func (m *manager) isDir(name string) bool {
+	info, err := m.stat(path.Clean(name))
+	return err == nil && info.IsDir()
+}
+
+// exists returns whether the passed name can be opened, resolving
+// fingerprinted names. Files are not opened to check it, these are
+// opened once when served.
+func (m *manager) exists(name string) bool {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		name = "."
+	}
+
+	name, ok := m.resolve(name)
+	if !ok {
+		return false
+	}
+
+	_, err := m.stat(name)
+	return err == nil
+}
+
+// notFound responds with the handler set with WithNotFoundHandler
+// or the default 404 response.
+func (m *manager) notFound(w http.ResponseWriter, r *http.Request) {
+	if m.notFoundHandler != nil {
+		m.notFoundHandler.ServeHTTP(w, r)
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
 func (m *manager) handlerPrefix() string {
 	return strings.TrimSuffix(m.servingPath, "*")
 }
+
+// seekableFile wraps a file that does not implement io.Seeker so the
+// handler can serve range requests for any file system. Files that
+// implement io.ReaderAt are read through a section reader, others are
+// loaded in memory.
+type seekableFile struct {
+	fs.File
+	reader io.ReadSeeker
+}
+
+// newSeekableFile returns the file wrapped in a seekableFile, directories
+// are returned as they are so these can still be listed.
+func newSeekableFile(file fs.File) (fs.File, error) {
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return file, nil
+	}
+
+	if ra, ok := file.(io.ReaderAt); ok {
+		return &seekableFile{
+			File:   file,
+			reader: io.NewSectionReader(ra, 0, info.Size()),
+		}, nil
+	}
+
+	bb, err := io.ReadAll(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &seekableFile{
+		File:   file,
+		reader: bytes.NewReader(bb),
+	}, nil
+}
+
+func (f *seekableFile) Read(p []byte) (int, error) {
+	return f.reader.Read(p)
+}
+
+func (f *seekableFile) Seek(offset int64, whence int) (int64, error) {
+	return f.reader.Seek(offset, whence)
+}
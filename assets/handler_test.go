@@ -0,0 +1,560 @@
+package assets_test
+
+import (
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+)
+
+func TestServingPath(t *testing.T) {
+	m := assets.NewManager(fstest.MapFS{
+		"main.js": {Data: []byte("AAA")},
+	}, assets.WithServingPath("/static"))
+
+	t.Run("handler pattern uses the prefix", func(t *testing.T) {
+		if p := m.HandlerPattern(); p != "/static/*" {
+			t.Errorf("Expected pattern to be /static/*, got %s", p)
+		}
+	})
+
+	t.Run("paths use the prefix", func(t *testing.T) {
+		a, err := m.PathFor("main.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := m.PathFor("/static/main.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a != b {
+			t.Errorf("Expected %s to equal %s", a, b)
+		}
+
+		if !strings.HasPrefix(a, "/static/main-") {
+			t.Errorf("Expected %s to start with /static/main-", a)
+		}
+	})
+
+	t.Run("handler serves the generated path", func(t *testing.T) {
+		p, err := m.PathFor("main.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, p, nil)
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, req)
+
+		if res.Code != http.StatusOK {
+			t.Errorf("Expected status code %d, got %d", http.StatusOK, res.Code)
+		}
+
+		if res.Body.String() != "AAA" {
+			t.Errorf("Expected body AAA, got %s", res.Body.String())
+		}
+	})
+
+	t.Run("handler opens the file once", func(t *testing.T) {
+		files := countingFS{
+			MapFS:  fstest.MapFS{"main.js": {Data: []byte("AAA")}},
+			opened: map[string]int{},
+		}
+
+		m := assets.NewManager(files, assets.WithServingPath("/static"))
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, httptest.NewRequest(http.MethodGet, "/static/main.js", nil))
+
+		if res.Code != http.StatusOK {
+			t.Errorf("Expected status code %d, got %d", http.StatusOK, res.Code)
+		}
+
+		if n := files.opened["main.js"]; n != 1 {
+			t.Errorf("Expected main.js to be opened once, got %d", n)
+		}
+	})
+
+	t.Run("prefix is normalized", func(t *testing.T) {
+		for _, prefix := range []string{"static", "/static/", "/static/*"} {
+			m := assets.NewManager(fstest.MapFS{}, assets.WithServingPath(prefix))
+			if p := m.HandlerPattern(); p != "/static/*" {
+				t.Errorf("Expected pattern for %q to be /static/*, got %s", prefix, p)
+			}
+		}
+	})
+}
+
+// nonSeekableFS wraps a file system hiding the Seek
+// method of the files it opens.
+type nonSeekableFS struct {
+	fstest.MapFS
+}
+
+func (n nonSeekableFS) Open(name string) (fs.File, error) {
+	f, err := n.MapFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return struct{ fs.File }{f}, nil
+}
+
+// readerAtFS wraps a file system hiding the Seek method of the
+// files it opens while keeping their ReadAt method.
+type readerAtFS struct {
+	fstest.MapFS
+}
+
+func (n readerAtFS) Open(name string) (fs.File, error) {
+	f, err := n.MapFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ra, ok := f.(io.ReaderAt)
+	if !ok {
+		return f, nil
+	}
+
+	return struct {
+		fs.File
+		io.ReaderAt
+	}{f, ra}, nil
+}
+
+func TestRangeRequests(t *testing.T) {
+	content := "0123456789abcdefghij"
+
+	testCases := []struct {
+		description string
+		manager     func(t *testing.T) http.HandlerFunc
+	}{
+		{
+			description: "embedded",
+			manager: func(t *testing.T) http.HandlerFunc {
+				return assets.NewManager(fstest.MapFS{
+					"video.mp4": {Data: []byte(content)},
+				}).HandlerFn
+			},
+		},
+		{
+			description: "embedded without seek",
+			manager: func(t *testing.T) http.HandlerFunc {
+				return assets.NewManager(nonSeekableFS{fstest.MapFS{
+					"video.mp4": {Data: []byte(content)},
+				}}).HandlerFn
+			},
+		},
+		{
+			description: "embedded with read at",
+			manager: func(t *testing.T) http.HandlerFunc {
+				return assets.NewManager(readerAtFS{fstest.MapFS{
+					"video.mp4": {Data: []byte(content)},
+				}}).HandlerFn
+			},
+		},
+		{
+			description: "folder",
+			manager: func(t *testing.T) http.HandlerFunc {
+				t.Setenv("GO_ENV", "development")
+
+				dir := t.TempDir()
+				err := os.WriteFile(filepath.Join(dir, "video.mp4"), []byte(content), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return assets.NewManager(fstest.MapFS{}, assets.WithOutputFolder(dir)).HandlerFn
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			handler := tc.manager(t)
+
+			req := httptest.NewRequest(http.MethodGet, "/public/video.mp4", nil)
+			req.Header.Set("Range", "bytes=5-9")
+			res := httptest.NewRecorder()
+			handler(res, req)
+
+			if res.Code != http.StatusPartialContent {
+				t.Fatalf("Expected status code %d, got %d", http.StatusPartialContent, res.Code)
+			}
+
+			if res.Body.String() != "56789" {
+				t.Errorf("Expected body 56789, got %s", res.Body.String())
+			}
+
+			if cr := res.Header().Get("Content-Range"); cr != "bytes 5-9/20" {
+				t.Errorf("Expected Content-Range bytes 5-9/20, got %s", cr)
+			}
+		})
+	}
+}
+
+func TestDevDirectoryListing(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "css"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "css", "app.css"), []byte("AAA"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.js"), []byte("BBB"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	embedded := fstest.MapFS{
+		"css/app.css": {Data: []byte("AAA")},
+		"main.js":     {Data: []byte("BBB")},
+	}
+
+	serve := func(m http.HandlerFunc, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		res := httptest.NewRecorder()
+		m(res, req)
+
+		return res
+	}
+
+	t.Run("lists entries in development", func(t *testing.T) {
+		t.Setenv("GO_ENV", "development")
+
+		m := assets.NewManager(embedded, assets.WithOutputFolder(dir), assets.WithDevDirectoryListing())
+
+		res := serve(m.HandlerFn, "/public/")
+		if res.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d, got %d", http.StatusOK, res.Code)
+		}
+
+		for _, entry := range []string{"css/", "main.js"} {
+			if !strings.Contains(res.Body.String(), entry) {
+				t.Errorf("Expected listing to contain %s, got %s", entry, res.Body.String())
+			}
+		}
+
+		res = serve(m.HandlerFn, "/public/css/")
+		if !strings.Contains(res.Body.String(), "app.css") {
+			t.Errorf("Expected listing to contain app.css, got %s", res.Body.String())
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv("GO_ENV", "development")
+
+		m := assets.NewManager(embedded, assets.WithOutputFolder(dir))
+
+		if res := serve(m.HandlerFn, "/public/css/"); res.Code != http.StatusNotFound {
+			t.Errorf("Expected status code %d, got %d", http.StatusNotFound, res.Code)
+		}
+	})
+
+	t.Run("not found in production", func(t *testing.T) {
+		t.Setenv("GO_ENV", "production")
+
+		m := assets.NewManager(embedded, assets.WithOutputFolder(dir), assets.WithDevDirectoryListing())
+
+		if res := serve(m.HandlerFn, "/public/css/"); res.Code != http.StatusNotFound {
+			t.Errorf("Expected status code %d, got %d", http.StatusNotFound, res.Code)
+		}
+
+		if res := serve(m.HandlerFn, "/public/css/app.css"); res.Code != http.StatusOK {
+			t.Errorf("Expected status code %d, got %d", http.StatusOK, res.Code)
+		}
+	})
+}
+
+func TestDevelopmentFallback(t *testing.T) {
+	t.Setenv("GO_ENV", "development")
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.js"), []byte("FOLDER"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := assets.NewManager(fstest.MapFS{
+		"main.js":          {Data: []byte("EMBEDDED")},
+		"vendor/htmx.js":   {Data: []byte("HTMX")},
+		"vendor/alpine.js": {Data: []byte("ALPINE")},
+	}, assets.WithOutputFolder(dir))
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, req)
+
+		return res
+	}
+
+	t.Run("prefers the folder", func(t *testing.T) {
+		res := serve("/public/main.js")
+		if res.Body.String() != "FOLDER" {
+			t.Errorf("Expected body FOLDER, got %s", res.Body.String())
+		}
+	})
+
+	t.Run("falls back to embedded", func(t *testing.T) {
+		res := serve("/public/vendor/htmx.js")
+		if res.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d, got %d", http.StatusOK, res.Code)
+		}
+
+		if res.Body.String() != "HTMX" {
+			t.Errorf("Expected body HTMX, got %s", res.Body.String())
+		}
+	})
+
+	t.Run("fingerprinted embedded files", func(t *testing.T) {
+		p, err := m.PathFor("vendor/alpine.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res := serve(p); res.Body.String() != "ALPINE" {
+			t.Errorf("Expected body ALPINE, got %s", res.Body.String())
+		}
+	})
+
+	t.Run("missing files", func(t *testing.T) {
+		if res := serve("/public/missing.js"); res.Code != http.StatusNotFound {
+			t.Errorf("Expected status code %d, got %d", http.StatusNotFound, res.Code)
+		}
+	})
+}
+
+func TestNotFoundHandler(t *testing.T) {
+	var misses []string
+	m := assets.NewManager(fstest.MapFS{
+		"main.js":     {Data: []byte("AAA")},
+		"css/app.css": {Data: []byte("BBB")},
+	}, assets.WithNotFoundHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		misses = append(misses, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Asset not found"))
+	})))
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, req)
+
+		return res
+	}
+
+	t.Run("missing asset", func(t *testing.T) {
+		res := serve("/public/missing.js")
+		if res.Code != http.StatusNotFound {
+			t.Errorf("Expected status code %d, got %d", http.StatusNotFound, res.Code)
+		}
+
+		if res.Body.String() != "Asset not found" {
+			t.Errorf("Expected the custom body, got %s", res.Body.String())
+		}
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		res := serve("/public/css/")
+		if res.Body.String() != "Asset not found" {
+			t.Errorf("Expected the custom body, got %s", res.Body.String())
+		}
+	})
+
+	t.Run("existing asset", func(t *testing.T) {
+		res := serve("/public/main.js")
+		if res.Code != http.StatusOK || res.Body.String() != "AAA" {
+			t.Errorf("Expected the asset to be served, got %d %s", res.Code, res.Body.String())
+		}
+	})
+
+	if len(misses) != 2 || misses[0] != "/public/missing.js" {
+		t.Errorf("Expected the misses to be handled, got %v", misses)
+	}
+}
+
+func TestHTTPFileSystem(t *testing.T) {
+	m := assets.NewManager(fstest.MapFS{
+		"main.js": {Data: []byte("AAA")},
+		"main.go": {Data: []byte("package public")},
+	})
+
+	fingerprinted, err := m.PathFor("main.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := http.StripPrefix("/public/", http.FileServer(m.HTTPFileSystem()))
+	serve := func(path string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		server.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+
+		return res
+	}
+
+	t.Run("serves files", func(t *testing.T) {
+		for _, path := range []string{"/public/main.js", fingerprinted} {
+			res := serve(path)
+			if res.Code != http.StatusOK || res.Body.String() != "AAA" {
+				t.Errorf("Expected %s to be served, got %d %s", path, res.Code, res.Body.String())
+			}
+		}
+	})
+
+	t.Run("blocks go files", func(t *testing.T) {
+		res := serve("/public/main.go")
+		if res.Code != http.StatusNotFound {
+			t.Errorf("Expected status code %d, got %d", http.StatusNotFound, res.Code)
+		}
+	})
+
+	t.Run("opens directories", func(t *testing.T) {
+		m := assets.NewManager(fstest.MapFS{
+			"docs/index.html": {Data: []byte("<h1>Docs</h1>")},
+		})
+
+		dir, err := m.HTTPFileSystem().Open("/docs")
+		if err != nil {
+			t.Fatalf("Expected the directory to be opened, got %v", err)
+		}
+
+		entries, err := dir.Readdir(-1)
+		dir.Close()
+		if err != nil || len(entries) != 1 {
+			t.Fatalf("Expected the directory to be listed, got %v %v", entries, err)
+		}
+
+		server := http.StripPrefix("/public/", http.FileServer(m.HTTPFileSystem()))
+		res := httptest.NewRecorder()
+		server.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/public/docs/", nil))
+		if res.Code != http.StatusOK || res.Body.String() != "<h1>Docs</h1>" {
+			t.Errorf("Expected the directory index to be served, got %d %s", res.Code, res.Body.String())
+		}
+	})
+}
+
+func TestCORS(t *testing.T) {
+	m := assets.NewManager(fstest.MapFS{
+		"font.woff2": {Data: []byte("AAA")},
+	}, assets.WithCORS("https://app.example.com"))
+
+	request := func(method, origin string, headers ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/public/font.woff2", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, req)
+
+		return res
+	}
+
+	t.Run("allowed origin", func(t *testing.T) {
+		res := request(http.MethodGet, "https://app.example.com")
+		if res.Code != http.StatusOK {
+			t.Errorf("Expected status code %d, got %d", http.StatusOK, res.Code)
+		}
+
+		if h := res.Header().Get("Access-Control-Allow-Origin"); h != "https://app.example.com" {
+			t.Errorf("Expected the origin to be echoed, got %q", h)
+		}
+
+		if h := res.Header().Get("Vary"); h != "Origin" {
+			t.Errorf("Expected Vary to be Origin, got %q", h)
+		}
+	})
+
+	t.Run("disallowed origin", func(t *testing.T) {
+		res := request(http.MethodGet, "https://evil.example.com")
+		if res.Code != http.StatusOK {
+			t.Errorf("Expected status code %d, got %d", http.StatusOK, res.Code)
+		}
+
+		if h := res.Header().Get("Access-Control-Allow-Origin"); h != "" {
+			t.Errorf("Expected no Access-Control-Allow-Origin, got %q", h)
+		}
+	})
+
+	t.Run("preflight", func(t *testing.T) {
+		res := request(http.MethodOptions, "https://app.example.com",
+			"Access-Control-Request-Method", "GET",
+			"Access-Control-Request-Headers", "Range",
+		)
+
+		if res.Code != http.StatusNoContent {
+			t.Errorf("Expected status code %d, got %d", http.StatusNoContent, res.Code)
+		}
+
+		if h := res.Header().Get("Access-Control-Allow-Origin"); h != "https://app.example.com" {
+			t.Errorf("Expected the origin to be echoed, got %q", h)
+		}
+
+		if h := res.Header().Get("Access-Control-Allow-Methods"); h != "GET, HEAD, OPTIONS" {
+			t.Errorf("Expected the allowed methods, got %q", h)
+		}
+
+		if h := res.Header().Get("Access-Control-Allow-Headers"); h != "Range" {
+			t.Errorf("Expected the requested headers to be allowed, got %q", h)
+		}
+
+		if res.Body.Len() != 0 {
+			t.Errorf("Expected an empty body, got %q", res.Body.String())
+		}
+	})
+
+	t.Run("disallowed preflight", func(t *testing.T) {
+		res := request(http.MethodOptions, "https://evil.example.com", "Access-Control-Request-Method", "GET")
+		if res.Code != http.StatusNoContent {
+			t.Errorf("Expected status code %d, got %d", http.StatusNoContent, res.Code)
+		}
+
+		if h := res.Header().Get("Access-Control-Allow-Origin"); h != "" {
+			t.Errorf("Expected no Access-Control-Allow-Origin, got %q", h)
+		}
+	})
+
+	t.Run("any origin", func(t *testing.T) {
+		m := assets.NewManager(fstest.MapFS{
+			"font.woff2": {Data: []byte("AAA")},
+		}, assets.WithCORS("*"))
+
+		req := httptest.NewRequest(http.MethodGet, "/public/font.woff2", nil)
+		req.Header.Set("Origin", "https://other.example.com")
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, req)
+
+		if h := res.Header().Get("Access-Control-Allow-Origin"); h != "https://other.example.com" {
+			t.Errorf("Expected the origin to be echoed, got %q", h)
+		}
+	})
+
+	t.Run("without CORS", func(t *testing.T) {
+		m := assets.NewManager(fstest.MapFS{
+			"font.woff2": {Data: []byte("AAA")},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/public/font.woff2", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		res := httptest.NewRecorder()
+		m.HandlerFn(res, req)
+
+		if h := res.Header().Get("Access-Control-Allow-Origin"); h != "" {
+			t.Errorf("Expected no Access-Control-Allow-Origin, got %q", h)
+		}
+	})
+}
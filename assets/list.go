@@ -0,0 +1,52 @@
+package assets
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Assets returns the serving paths of all the files the manager can
+// serve, sorted by name. Files that are never served, like Go source
+// files, are left out. Paths include the serving prefix so they can
+// be used as URLs, e.g. to build sitemaps or manifests. In development
+// the files of the output folder and the embedded ones are listed, as
+// both are served.
+func (m *manager) Assets() ([]string, error) {
+	sources := []fs.FS{m.embedded}
+	if os.Getenv("GO_ENV") == "development" {
+		sources = []fs.FS{m.folder, m.embedded}
+	}
+
+	seen := map[string]bool{}
+	var paths []string
+	for i, source := range sources {
+		err := fs.WalkDir(source, ".", func(name string, d fs.DirEntry, err error) error {
+			// The output folder may not exist yet, e.g. before the first copy.
+			if err != nil && name == "." && i < len(sources)-1 && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() || filepath.Ext(name) == ".go" || seen[name] {
+				return nil
+			}
+
+			seen[name] = true
+			paths = append(paths, m.withPrefix(name))
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
@@ -0,0 +1,76 @@
+package assets_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+)
+
+func TestAssets(t *testing.T) {
+	t.Run("embedded files", func(t *testing.T) {
+		m := assets.NewManager(fstest.MapFS{
+			"main.js":         {Data: []byte("AAA")},
+			"css/app.css":     {Data: []byte("BBB")},
+			"images/logo.png": {Data: []byte("CCC")},
+			"embed.go":        {Data: []byte("package public")},
+		})
+
+		paths, err := m.Assets()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"/public/css/app.css", "/public/images/logo.png", "/public/main.js"}
+		if !slices.Equal(paths, expected) {
+			t.Errorf("Expected %v, got %v", expected, paths)
+		}
+	})
+
+	t.Run("output folder in development", func(t *testing.T) {
+		t.Setenv("GO_ENV", "development")
+
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "main.js"), []byte("AAA"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		m := assets.NewManager(fstest.MapFS{
+			"other.js": {Data: []byte("BBB")},
+			"main.js":  {Data: []byte("CCC")},
+		}, assets.WithOutputFolder(dir), assets.WithServingPath("/static/"))
+
+		paths, err := m.Assets()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Embedded files are listed too since these are served as
+		// a fallback, files in both are listed once.
+		expected := []string{"/static/main.js", "/static/other.js"}
+		if !slices.Equal(paths, expected) {
+			t.Errorf("Expected %v, got %v", expected, paths)
+		}
+	})
+
+	t.Run("missing output folder in development", func(t *testing.T) {
+		t.Setenv("GO_ENV", "development")
+
+		m := assets.NewManager(fstest.MapFS{
+			"main.js": {Data: []byte("AAA")},
+		}, assets.WithOutputFolder(filepath.Join(t.TempDir(), "missing")))
+
+		paths, err := m.Assets()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"/public/main.js"}
+		if !slices.Equal(paths, expected) {
+			t.Errorf("Expected %v, got %v", expected, paths)
+		}
+	})
+}
@@ -2,8 +2,10 @@ package assets
 
 import (
 	"io/fs"
+	"net/http"
 	"os"
 	"sync"
+	"time"
 )
 
 type manager struct {
@@ -13,20 +15,32 @@ type manager struct {
 	outputFolder string
 	inputFolder  string
 
-	servingPath string
+	servingPath      string
+	directoryListing bool
+	notFoundHandler  http.Handler
+	corsOrigins      []string
 
-	fmut       sync.Mutex
-	fileToHash map[string]string
-	HashToFile map[string]string
+	optimizeImages bool
+	imageQuality   int
+	generateWebP   bool
+	rewriteCSSURLs bool
+
+	version string
+
+	onRebuild    []func()
+	pollInterval time.Duration
+
+	fmut            sync.RWMutex
+	fileToHash      map[string]string
+	fileToIntegrity map[string]string
+	HashToFile      map[string]string
 }
 
 // NewManager returns a new manager that wraps the given embed.FS and the input and output folders.
-func NewManager(embedded fs.FS) *manager {
+func NewManager(embedded fs.FS, options ...Option) *manager {
 	// TODO: options to change:
 	// - input
-	// - output.
-	// - serving path.
-	return &manager{
+	m := &manager{
 		embedded: embedded,
 		folder:   os.DirFS("public"),
 
@@ -34,7 +48,14 @@ func NewManager(embedded fs.FS) *manager {
 		outputFolder: "public",
 		servingPath:  "/public/*",
 
-		fileToHash: map[string]string{},
-		HashToFile: map[string]string{},
+		fileToHash:      map[string]string{},
+		fileToIntegrity: map[string]string{},
+		HashToFile:      map[string]string{},
 	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
 }
@@ -0,0 +1,63 @@
+package assets_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+)
+
+func TestOutputFolder(t *testing.T) {
+	inTempDir(t)
+
+	err := os.WriteFile(filepath.Join("internal", "assets", "main.js"), []byte("AAA"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	public := assets.NewManager(fstest.MapFS{}, assets.WithOutputFolder("public"))
+	admin := assets.NewManager(fstest.MapFS{}, assets.WithOutputFolder(filepath.Join("admin", "public")))
+
+	if err := public.CopyAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := admin.CopyAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, folder := range []string{"public", filepath.Join("admin", "public")} {
+		bb, err := os.ReadFile(filepath.Join(folder, "main.js"))
+		if err != nil {
+			t.Fatalf("Expected main.js in %s, got %v", folder, err)
+		}
+
+		if string(bb) != "AAA" {
+			t.Errorf("Expected %s/main.js to contain AAA, got %s", folder, bb)
+		}
+	}
+
+	t.Run("serves from the output folder in development", func(t *testing.T) {
+		t.Setenv("GO_ENV", "development")
+
+		err := os.WriteFile(filepath.Join("admin", "public", "admin.js"), []byte("BBB"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		bb, err := admin.ReadFile("admin.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(bb) != "BBB" {
+			t.Errorf("Expected admin.js to contain BBB, got %s", bb)
+		}
+
+		if _, err := public.ReadFile("admin.js"); err == nil {
+			t.Errorf("Expected admin.js to not be found in the public manager")
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package assets
+
+import (
+	"bytes"
+	"image/jpeg"
+	"image/png"
+	"path/filepath"
+	"strings"
+)
+
+// optimizeImage re-encodes png and jpeg images to reduce their size.
+// If the image cannot be decoded, the format is not supported or the
+// result is not smaller than the original, the original bytes are returned.
+func (m *manager) optimizeImage(name string, original []byte) []byte {
+	var buf bytes.Buffer
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".png":
+		img, err := png.Decode(bytes.NewReader(original))
+		if err != nil {
+			return original
+		}
+
+		enc := png.Encoder{CompressionLevel: png.BestCompression}
+		if err := enc.Encode(&buf, img); err != nil {
+			return original
+		}
+	case ".jpg", ".jpeg":
+		// JPEG can't be re-encoded losslessly, only do it
+		// when a quality has been specified.
+		if m.imageQuality <= 0 {
+			return original
+		}
+
+		img, err := jpeg.Decode(bytes.NewReader(original))
+		if err != nil {
+			return original
+		}
+
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: m.imageQuality}); err != nil {
+			return original
+		}
+	default:
+		return original
+	}
+
+	if buf.Len() >= len(original) {
+		return original
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,99 @@
+package assets_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+)
+
+// inTempDir changes the working directory to a temporary folder
+// that contains the default input folder for the assets manager.
+func inTempDir(t *testing.T) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "internal", "assets"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func TestImageOptimize(t *testing.T) {
+	inTempDir(t)
+
+	img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 64; y++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 10, B: 10, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := png.Encoder{CompressionLevel: png.NoCompression}
+	if err := enc.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	input := buf.Bytes()
+	files := map[string][]byte{
+		"image.png": input,
+		"app.js":    []byte("console.log('hello')"),
+		"fake.png":  []byte("not an image"),
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join("internal", "assets", name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := assets.NewManager(fstest.MapFS{}, assets.WithImageOptimize())
+	if err := m.CopyAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("image is not larger and decodes", func(t *testing.T) {
+		output, err := os.ReadFile(filepath.Join("public", "image.png"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(output) > len(input) {
+			t.Errorf("Expected output (%d bytes) to be no larger than input (%d bytes)", len(output), len(input))
+		}
+
+		if _, err := png.Decode(bytes.NewReader(output)); err != nil {
+			t.Errorf("Expected output to decode, got %v", err)
+		}
+	})
+
+	t.Run("unsupported files are copied as is", func(t *testing.T) {
+		for _, name := range []string{"app.js", "fake.png"} {
+			output, err := os.ReadFile(filepath.Join("public", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(output, files[name]) {
+				t.Errorf("Expected %s to be copied unchanged, got %q", name, output)
+			}
+		}
+	})
+}
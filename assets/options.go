@@ -0,0 +1,113 @@
+package assets
+
+import (
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// Option allows to customize the manager when it's created.
+type Option func(*manager)
+
+// WithImageOptimize enables the optimization of .png and .jpg images
+// when these are copied to the output folder. PNG images are recompressed
+// losslessly. JPEG images are only re-encoded when a quality (1-100) is
+// passed. Images that can't be made smaller are copied as they are.
+func WithImageOptimize(quality ...int) Option {
+	return func(m *manager) {
+		m.optimizeImages = true
+		if len(quality) > 0 {
+			m.imageQuality = quality[0]
+		}
+	}
+}
+
+// WithWebP enables the generation of .webp variants for the .png and
+// .jpg images when these are copied to the output folder. The variant is
+// placed next to the original image, replacing its extension with .webp.
+func WithWebP() Option {
+	return func(m *manager) {
+		m.generateWebP = true
+	}
+}
+
+// WithCSSURLRewrite rewrites the url() references in the stylesheets to
+// the fingerprinted paths of the referenced assets when these are served,
+// so images and fonts referenced from CSS keep working with content hashing.
+func WithCSSURLRewrite() Option {
+	return func(m *manager) {
+		m.rewriteCSSURLs = true
+	}
+}
+
+// WithPolling makes Watch scan the input folder every interval instead
+// of relying on filesystem events, which don't fire on some network
+// mounts and containers. Files are considered changed when their
+// modification time or size changes. Watch also falls back to polling
+// when the filesystem watcher can't be created.
+func WithPolling(interval time.Duration) Option {
+	return func(m *manager) {
+		m.pollInterval = interval
+	}
+}
+
+// WithOutputFolder sets the folder where CopyAll and Watch place the
+// assets, this folder is also the one used to serve the files in
+// development. By default this is set to "public".
+func WithOutputFolder(folder string) Option {
+	return func(m *manager) {
+		m.outputFolder = folder
+		m.folder = os.DirFS(folder)
+	}
+}
+
+// WithServingPath sets the path prefix the assets are served under,
+// this drives both the HandlerPattern and the paths generated by PathFor.
+// By default this is set to "/public/*".
+func WithServingPath(prefix string) Option {
+	return func(m *manager) {
+		prefix = strings.Trim(strings.TrimSuffix(prefix, "*"), "/")
+		m.servingPath = path.Join("/", prefix, "*")
+	}
+}
+
+// WithDevDirectoryListing enables listing the contents of the folders
+// under the serving path, which helps finding missing files. Listings
+// are only served when GO_ENV is development, otherwise directory
+// requests respond with 404.
+func WithDevDirectoryListing() Option {
+	return func(m *manager) {
+		m.directoryListing = true
+	}
+}
+
+// WithVersion makes PathFor version the assets with the passed string,
+// e.g. a build timestamp or commit, instead of hashing their contents.
+// Paths are returned with the version as the v query parameter, like
+// "/public/main.js?v=<version>", so all of them change at once.
+func WithVersion(version string) Option {
+	return func(m *manager) {
+		m.version = version
+	}
+}
+
+// WithNotFoundHandler sets the handler used to respond when a requested
+// asset does not exist, e.g. to render a branded page or log the misses.
+// By default a plain 404 response is written.
+func WithNotFoundHandler(h http.Handler) Option {
+	return func(m *manager) {
+		m.notFoundHandler = h
+	}
+}
+
+// WithCORS makes the handler serve the assets with CORS headers for the
+// allowed origins, e.g. fonts used from other domains. Matching origins
+// are echoed in Access-Control-Allow-Origin, "*" allows any origin, and
+// preflight OPTIONS requests are answered with 204 No Content.
+func WithCORS(allowedOrigins ...string) Option {
+	return func(m *manager) {
+		m.corsOrigins = append(m.corsOrigins, allowedOrigins...)
+	}
+}
@@ -1,70 +1,206 @@
 package assets
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 )
 
-// manager watches the input folder and copies all files to the output folder.
-// It also watches for changes in the input folder and copies the files again.
-func (m *manager) Watch() {
+// Watch copies all files from the input folder to the output folder and
+// keeps watching the input folder to copy the files again when these change.
+// It blocks until the watcher stops, errors setting up the watcher are logged
+// and returned.
+func (m *manager) Watch() error {
+	err := m.WatchContext(context.Background())
+	if err != nil {
+		log.Println(err)
+	}
+
+	return err
+}
+
+// WatchContext works like Watch but stops watching when the context is
+// done, in which case it returns nil. Errors setting up the watcher are
+// returned so the caller can decide how to handle them.
+func (m *manager) WatchContext(ctx context.Context) error {
 	err := m.CopyAll()
 	if err != nil {
 		log.Println(err)
 	}
 
+	if m.pollInterval > 0 {
+		return m.poll(ctx, m.pollInterval)
+	}
+
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		panic(fmt.Errorf("error creating watcher: %w", err))
+		log.Printf("error creating watcher, falling back to polling: %v", err)
+		return m.poll(ctx, defaultPollInterval)
 	}
 
+	defer watcher.Close()
+
 	// Add all folders within the assets folder to the watcher.
 	err = filepath.Walk(m.inputFolder, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
 		return watcher.Add(path)
 	})
 
 	if err != nil {
-		panic(fmt.Errorf("error adding files to watcher: %w", err))
+		return fmt.Errorf("error adding files to watcher: %w", err)
 	}
 
-	go func() {
-		for {
-			select {
-			case event, ok := <-watcher.Events:
-				if !ok {
-					continue
-				}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
 
-				needsCopy := event.Has(fsnotify.Create) || event.Has(fsnotify.Write) || event.Has(fsnotify.Rename)
-				if !needsCopy {
-					continue
-				}
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
 
-				err := m.CopyAll()
-				if err != nil {
-					log.Println(err)
-				}
+			needsCopy := event.Has(fsnotify.Create) || event.Has(fsnotify.Write) || event.Has(fsnotify.Rename)
+			if !needsCopy {
+				continue
+			}
 
-				if event.Has(fsnotify.Create) {
-					watcher.Add(event.Name)
-				}
+			err := m.CopyAll()
+			if err != nil {
+				log.Println(err)
+			}
 
-			case err, ok := <-watcher.Errors:
-				if !ok {
-					return
-				}
+			if err == nil {
+				m.rebuilt()
+			}
+
+			if event.Has(fsnotify.Create) {
+				watcher.Add(event.Name)
+			}
 
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			log.Println("error:", err)
+		}
+	}
+}
+
+// defaultPollInterval is used when Watch falls back to polling
+// because the filesystem watcher can't be created.
+const defaultPollInterval = time.Second
+
+// fileState is what polling compares to detect changes in a file.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// poll scans the input folder every interval and copies the
+// files again when any of them was added, changed or removed.
+func (m *manager) poll(ctx context.Context, interval time.Duration) error {
+	last, err := m.scan()
+	if err != nil {
+		return fmt.Errorf("error scanning input folder: %w", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			current, err := m.scan()
+			if err != nil {
 				log.Println("error:", err)
+				continue
+			}
+
+			if !changed(last, current) {
+				continue
+			}
+
+			last = current
+			err = m.CopyAll()
+			if err != nil {
+				log.Println(err)
+				continue
 			}
+
+			m.rebuilt()
 		}
-	}()
+	}
+}
 
-	<-make(chan struct{})
+// scan returns the state of the files within the input folder.
+func (m *manager) scan() (map[string]fileState, error) {
+	states := map[string]fileState{}
+	err := filepath.Walk(m.inputFolder, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			states[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+
+		return nil
+	})
+
+	return states, err
+}
+
+// changed reports whether the files differ between both scans.
+func changed(last, current map[string]fileState) bool {
+	if len(last) != len(current) {
+		return true
+	}
+
+	for path, state := range current {
+		prev, ok := last[path]
+		if !ok || prev.size != state.size || !prev.modTime.Equal(state.modTime) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OnRebuild registers a function to be called each time Watch
+// copies the files successfully after a change in the input folder.
+// Callbacks should be registered before calling Watch.
+func (m *manager) OnRebuild(fn func()) {
+	m.onRebuild = append(m.onRebuild, fn)
+}
+
+// rebuilt runs the rebuild callbacks, a panic in a callback
+// is logged so it doesn't stop the watcher.
+func (m *manager) rebuilt() {
+	for _, fn := range m.onRebuild {
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					log.Println("error running rebuild callback:", err)
+				}
+			}()
+
+			fn()
+		}()
+	}
 }
 
 // CopyAll copies all files from the input folder to the output folder.
@@ -95,29 +231,61 @@ func (m *manager) CopyAll() error {
 
 		// Copy the file to the destination folder
 		destPath := filepath.Join(destFolder, filepath.Base(relativePath))
-		srcFile, err := os.Open(path)
+		return m.copyFile(path, destPath)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error copying files: %w", err)
+	}
+
+	return nil
+}
+
+// copyFile copies the src file into dest applying the image
+// optimizations and conversions enabled in the manager.
+func (m *manager) copyFile(src, dest string) error {
+	if !isImage(src) || (!m.optimizeImages && !m.generateWebP) {
+		srcFile, err := os.Open(src)
 		if err != nil {
 			return err
 		}
 		defer srcFile.Close()
 
-		destFile, err := os.Create(destPath)
+		destFile, err := os.Create(dest)
 		if err != nil {
 			return err
 		}
 		defer destFile.Close()
 
 		_, err = io.Copy(destFile, srcFile)
-		if err != nil {
-			return err
-		}
+		return err
+	}
+
+	original, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	bb := original
+	if m.optimizeImages {
+		bb = m.optimizeImage(src, original)
+	}
+
+	err = os.WriteFile(dest, bb, 0644)
+	if err != nil {
+		return err
+	}
 
+	if !m.generateWebP {
 		return nil
-	})
+	}
 
+	wb, err := encodeWebP(original)
 	if err != nil {
-		return fmt.Errorf("error copying files: %w", err)
+		// Images that can't be decoded are copied without
+		// generating the WebP variant.
+		return nil
 	}
 
-	return nil
+	return os.WriteFile(webpVariant(dest), wb, 0644)
 }
@@ -0,0 +1,177 @@
+package assets_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/leapkit/core/assets"
+)
+
+func TestOnRebuild(t *testing.T) {
+	inTempDir(t)
+
+	m := assets.NewManager(fstest.MapFS{})
+
+	rebuilt := make(chan struct{}, 1)
+	m.OnRebuild(func() {
+		panic("callback errors should not stop the watcher")
+	})
+
+	m.OnRebuild(func() {
+		select {
+		case rebuilt <- struct{}{}:
+		default:
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go m.WatchContext(ctx)
+
+	// The file is written until the watcher picks the
+	// change up since it takes a moment to start.
+	timeout := time.After(5 * time.Second)
+	for {
+		err := os.WriteFile(filepath.Join("internal", "assets", "main.js"), []byte("AAA"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case <-rebuilt:
+			if _, err := os.Stat(filepath.Join("public", "main.js")); err != nil {
+				t.Errorf("Expected main.js to be copied before the callback, got %v", err)
+			}
+
+			return
+		case <-timeout:
+			t.Fatal("Expected the rebuild callback to be called")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+func TestWatchErrors(t *testing.T) {
+	t.Run("returns the error adding the input folder", func(t *testing.T) {
+		inTempDir(t)
+
+		if err := os.RemoveAll(filepath.Join("internal", "assets")); err != nil {
+			t.Fatal(err)
+		}
+
+		m := assets.NewManager(fstest.MapFS{})
+		if err := m.WatchContext(context.Background()); err == nil {
+			t.Fatal("Expected an error watching a missing folder")
+		}
+
+		if err := m.Watch(); err == nil {
+			t.Fatal("Expected an error watching a missing folder")
+		}
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		inTempDir(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+
+		m := assets.NewManager(fstest.MapFS{})
+		go func() { done <- m.WatchContext(ctx) }()
+
+		cancel()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Expected no error after cancelling, got %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected the watcher to stop")
+		}
+	})
+}
+
+func TestWatchPolling(t *testing.T) {
+	t.Run("rebuilds when a file changes", func(t *testing.T) {
+		inTempDir(t)
+
+		src := filepath.Join("internal", "assets", "main.js")
+		if err := os.WriteFile(src, []byte("AAA"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		rebuilt := make(chan struct{}, 1)
+		m := assets.NewManager(fstest.MapFS{}, assets.WithPolling(10*time.Millisecond))
+		m.OnRebuild(func() {
+			select {
+			case rebuilt <- struct{}{}:
+			default:
+			}
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go m.WatchContext(ctx)
+
+		// Give the first scan a moment before changing the file,
+		// the size changes so the mtime granularity doesn't matter.
+		time.Sleep(50 * time.Millisecond)
+		if err := os.WriteFile(src, []byte("BBBBBB"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case <-rebuilt:
+			bb, err := os.ReadFile(filepath.Join("public", "main.js"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(bb) != "BBBBBB" {
+				t.Errorf("Expected the changed content to be copied, got %q", bb)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected the rebuild callback to be called")
+		}
+	})
+
+	t.Run("returns the error scanning the input folder", func(t *testing.T) {
+		inTempDir(t)
+
+		if err := os.RemoveAll(filepath.Join("internal", "assets")); err != nil {
+			t.Fatal(err)
+		}
+
+		m := assets.NewManager(fstest.MapFS{}, assets.WithPolling(10*time.Millisecond))
+		if err := m.WatchContext(context.Background()); err == nil {
+			t.Fatal("Expected an error polling a missing folder")
+		}
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		inTempDir(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+
+		m := assets.NewManager(fstest.MapFS{}, assets.WithPolling(10*time.Millisecond))
+		go func() { done <- m.WatchContext(ctx) }()
+
+		cancel()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Expected no error after cancelling, got %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected the watcher to stop")
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package assets
+
+import (
+	"bytes"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+)
+
+// WebPPathFor returns the fingerprinted path for the .webp variant
+// of the given image. It's useful to build <picture> elements with
+// a WebP source and the original image as fallback:
+//
+//	<picture>
+//	  <source srcset="<%= assets.WebPPathFor("images/logo.png") %>" type="image/webp">
+//	  <img src="<%= assets.PathFor("images/logo.png") %>">
+//	</picture>
+func (m *manager) WebPPathFor(fname string) (string, error) {
+	return m.PathFor(webpVariant(fname))
+}
+
+// isImage returns true when the file is one of the image
+// formats the manager can optimize and convert.
+func isImage(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".png", ".jpg", ".jpeg":
+		return true
+	}
+
+	return false
+}
+
+// webpVariant returns the name of the .webp variant for a file.
+func webpVariant(name string) string {
+	return strings.TrimSuffix(name, path.Ext(name)) + ".webp"
+}
+
+// encodeWebP decodes a png or jpeg image and encodes it
+// as a lossless WebP image.
+func encodeWebP(data []byte) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = nativewebp.Encode(&buf, img, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,89 @@
+package assets_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+	"golang.org/x/image/webp"
+)
+
+func TestWebP(t *testing.T) {
+	inTempDir(t)
+
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 8))
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 8; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 16), G: uint8(y * 32), B: 100, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	err := os.WriteFile(filepath.Join("internal", "assets", "image.png"), buf.Bytes(), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join("internal", "assets", "app.js"), []byte("console.log('hello')"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := assets.NewManager(fstest.MapFS{}, assets.WithWebP())
+	if err := m.CopyAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("png yields a valid webp", func(t *testing.T) {
+		data, err := os.ReadFile(filepath.Join("public", "image.webp"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out, err := webp.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("Expected a valid webp, got %v", err)
+		}
+
+		if out.Bounds() != img.Bounds() {
+			t.Errorf("Expected bounds %v, got %v", img.Bounds(), out.Bounds())
+		}
+
+		if _, err := os.Stat(filepath.Join("public", "image.png")); err != nil {
+			t.Errorf("Expected original image to be copied, got %v", err)
+		}
+	})
+
+	t.Run("non images have no webp variant", func(t *testing.T) {
+		if _, err := os.Stat(filepath.Join("public", "app.webp")); !os.IsNotExist(err) {
+			t.Errorf("Expected app.webp to not exist, got %v", err)
+		}
+	})
+
+	t.Run("path for the webp variant", func(t *testing.T) {
+		m := assets.NewManager(fstest.MapFS{
+			"image.png":  {Data: []byte("PNG")},
+			"image.webp": {Data: []byte("WEBP")},
+		})
+
+		p, err := m.WebPPathFor("/public/image.png")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.HasPrefix(p, "/public/image-") || !strings.HasSuffix(p, ".webp") {
+			t.Errorf("Expected %s to be the fingerprinted webp path", p)
+		}
+	})
+}
@@ -1,8 +1,11 @@
 package form
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"reflect"
 	"strings"
 
 	"github.com/go-playground/form/v4"
@@ -30,20 +33,122 @@ func RegisterCustomTypeFunc(fn form.DecodeCustomTypeFunc, kind interface{}) {
 	decoder.RegisterCustomTypeFunc(fn, kind)
 }
 
+// ErrTooManyValues is returned by Decode when a field has more
+// values than the maximum set with WithMaxValuesPerField.
+var ErrTooManyValues = errors.New("too many values")
+
+// PostDecode is implemented by types that need to run some processing
+// after being decoded, such as normalizing values. Decode calls the
+// AfterDecode method when the destination implements it and returns
+// its error.
+type PostDecode interface {
+	AfterDecode() error
+}
+
 // Decode decodes the request body into dst, which must be a pointer of a struct.
 // If there is no body or the body is empty, it will take the query string as the
 // body. If the Content-Type is multipart/form-data.
-func Decode(r *http.Request, dst interface{}) error {
+// Options can be passed to customize the decoding, see the With* functions.
+func Decode(r *http.Request, dst interface{}, options ...Option) error {
+	_, err := DecodeWithValues(r, dst, options...)
+	return err
+}
+
+// DecodeWithValues decodes the request like Decode and also returns the raw
+// values it consumed. Raw values are returned even when decoding fails so
+// forms can be repopulated with exactly what the user typed, e.g. a date
+// that could not be parsed.
+func DecodeWithValues(r *http.Request, dst interface{}, options ...Option) (url.Values, error) {
+	opts := &decodeOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+
+	raw, err := parseForm(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.maxValues > 0 {
+		for key, vals := range raw {
+			if len(vals) > opts.maxValues {
+				return raw, fmt.Errorf("%w: %s has %d values, the maximum is %d", ErrTooManyValues, key, len(vals), opts.maxValues)
+			}
+		}
+	}
+
+	values := raw
+	if opts.caseInsensitive {
+		values = matchKeys(dst, values)
+	}
+
+	err = decoder.Decode(dst, values)
+	if err != nil {
+		return raw, err
+	}
+
+	if opts.trimSpace {
+		trimSpace(reflect.ValueOf(dst))
+	}
+
+	if opts.emptyAsNil {
+		emptyAsNil(reflect.ValueOf(dst), values, "")
+	}
+
+	if pd, ok := dst.(PostDecode); ok {
+		if err := pd.AfterDecode(); err != nil {
+			return raw, err
+		}
+	}
+
+	return raw, nil
+}
+
+// DecodeSlice decodes the values of a single field into dst, which must be
+// a pointer to a slice, e.g. *[]int for "ids=1&ids=2&ids=3". The values
+// are converted to the element type of the slice, including the types
+// registered with RegisterCustomTypeFunc.
+func DecodeSlice(r *http.Request, field string, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("form: DecodeSlice expects a pointer to a slice, got %T", dst)
+	}
+
+	values, err := parseForm(r)
+	if err != nil {
+		return err
+	}
+
+	// The slice is wrapped in a struct so it's decoded with the
+	// same decoder and conversions as other fields.
+	wrapper := reflect.New(reflect.StructOf([]reflect.StructField{{
+		Name: "Values",
+		Type: rv.Elem().Type(),
+		Tag:  reflect.StructTag(fmt.Sprintf(`form:"%s"`, field)),
+	}}))
+
+	err = decoder.Decode(wrapper.Interface(), url.Values{field: values[field]})
+	if err != nil {
+		return err
+	}
+
+	rv.Elem().Set(wrapper.Elem().Field(0))
+	return nil
+}
+
+// parseForm parses the request form, reading multipart bodies when
+// needed. When the body has no values the query string is used.
+func parseForm(r *http.Request) (url.Values, error) {
 	//MultipartForm
 	if strings.Contains(r.Header.Get("Content-Type"), "multipart/form-data") {
 		err := r.ParseMultipartForm(32 << 20)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		err := r.ParseForm()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -52,8 +157,7 @@ func Decode(r *http.Request, dst interface{}) error {
 		r.Form = r.URL.Query()
 	}
 
-	err := decoder.Decode(dst, r.Form)
-	return err
+	return r.Form, nil
 }
 
 // decodeUUID a single uuid from a string
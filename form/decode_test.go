@@ -2,6 +2,8 @@ package form_test
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"mime/multipart"
 	"net/http"
 	"net/url"
@@ -301,3 +303,442 @@ func TestDecodeUUIDSlice(t *testing.T) {
 	})
 
 }
+
+func TestDecodeTrimSpace(t *testing.T) {
+	vals := url.Values{
+		"name": []string{"  Antonio  "},
+		"nick": []string{"\tapagano\n"},
+		"tags": []string{" a ", "b "},
+		"bio":  []string{"  keep me  "},
+	}
+
+	type person struct {
+		Name string   `form:"name"`
+		Nick *string  `form:"nick"`
+		Tags []string `form:"tags"`
+		Bio  string   `form:"bio,notrim"`
+	}
+
+	t.Run("trims string fields", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var p person
+		err = form.Decode(tr, &p, form.WithTrimSpace())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Name != "Antonio" {
+			t.Fatalf("expected Antonio, got %q", p.Name)
+		}
+
+		if p.Nick == nil || *p.Nick != "apagano" {
+			t.Fatalf("expected apagano, got %v", p.Nick)
+		}
+
+		if p.Tags[0] != "a" || p.Tags[1] != "b" {
+			t.Fatalf("expected [a b], got %q", p.Tags)
+		}
+
+		if p.Bio != "  keep me  " {
+			t.Fatalf("expected notrim field to keep whitespace, got %q", p.Bio)
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var p person
+		err = form.Decode(tr, &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Name != "  Antonio  " {
+			t.Fatalf("expected whitespace to be kept, got %q", p.Name)
+		}
+	})
+}
+
+func TestDecodeEmptyAsNil(t *testing.T) {
+	type address struct {
+		Street *string `form:"street"`
+	}
+
+	type profile struct {
+		Nick    *string  `form:"nick"`
+		Age     *int     `form:"age"`
+		Admin   *bool    `form:"admin"`
+		Bio     *string  `form:"bio"`
+		Score   *float64 `form:"score"`
+		Address address  `form:"address"`
+	}
+
+	vals := url.Values{
+		"nick":           []string{""},
+		"age":            []string{""},
+		"admin":          []string{""},
+		"bio":            []string{"Hello"},
+		"score":          []string{"0"},
+		"address.street": []string{""},
+	}
+
+	t.Run("empty values are nil", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var p profile
+		err = form.Decode(tr, &p, form.WithEmptyAsNil())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Nick != nil || p.Age != nil || p.Admin != nil || p.Address.Street != nil {
+			t.Fatalf("expected empty values to be nil, got %v %v %v %v", p.Nick, p.Age, p.Admin, p.Address.Street)
+		}
+	})
+
+	t.Run("non-empty values are not nil", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var p profile
+		err = form.Decode(tr, &p, form.WithEmptyAsNil())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Bio == nil || *p.Bio != "Hello" {
+			t.Fatalf("expected bio to be Hello, got %v", p.Bio)
+		}
+
+		if p.Score == nil || *p.Score != 0 {
+			t.Fatalf("expected score to be 0, got %v", p.Score)
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var p profile
+		err = form.Decode(tr, &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Nick == nil {
+			t.Fatal("expected nick to point to an empty string")
+		}
+	})
+}
+
+func TestDecodeCaseInsensitiveKeys(t *testing.T) {
+	type address struct {
+		City string
+	}
+
+	type contact struct {
+		Email   string
+		Name    string `form:"FullName"`
+		Tags    []string
+		Address address
+	}
+
+	t.Run("matches keys ignoring case", func(t *testing.T) {
+		vals := url.Values{
+			"email":        []string{"a@pagano.id"},
+			"fullname":     []string{"Antonio"},
+			"tags[0]":      []string{"a"},
+			"address.city": []string{"Caracas"},
+		}
+
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var c contact
+		err = form.Decode(tr, &c, form.WithCaseInsensitiveKeys())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Email != "a@pagano.id" || c.Name != "Antonio" || c.Address.City != "Caracas" {
+			t.Fatalf("expected values to be decoded, got %+v", c)
+		}
+
+		if len(c.Tags) != 1 || c.Tags[0] != "a" {
+			t.Fatalf("expected tags to be [a], got %v", c.Tags)
+		}
+	})
+
+	t.Run("exact matches take precedence", func(t *testing.T) {
+		vals := url.Values{
+			"email": []string{"lower@pagano.id"},
+			"Email": []string{"exact@pagano.id"},
+		}
+
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var c contact
+		err = form.Decode(tr, &c, form.WithCaseInsensitiveKeys())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Email != "exact@pagano.id" {
+			t.Fatalf("expected exact@pagano.id, got %s", c.Email)
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?email=a@pagano.id", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var c contact
+		err = form.Decode(tr, &c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Email != "" {
+			t.Fatalf("expected email to be empty, got %s", c.Email)
+		}
+	})
+}
+
+func TestDecodeWithValues(t *testing.T) {
+	type person struct {
+		Name string `form:"name"`
+		Age  int    `form:"age"`
+	}
+
+	t.Run("returns raw values", func(t *testing.T) {
+		vals := url.Values{
+			"name": []string{"Antonio"},
+			"age":  []string{"31"},
+		}
+
+		tr, err := http.NewRequest("POST", "/", strings.NewReader(vals.Encode()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tr.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var p person
+		raw, err := form.DecodeWithValues(tr, &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Age != 31 {
+			t.Fatalf("expected 31, got %v", p.Age)
+		}
+
+		if raw.Get("name") != "Antonio" || raw.Get("age") != "31" {
+			t.Fatalf("expected raw values to match submission, got %v", raw)
+		}
+	})
+
+	t.Run("preserves values that failed to decode", func(t *testing.T) {
+		vals := url.Values{
+			"name": []string{"Antonio"},
+			"age":  []string{"thirty one"},
+		}
+
+		tr, err := http.NewRequest("POST", "/", strings.NewReader(vals.Encode()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tr.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var p person
+		raw, err := form.DecodeWithValues(tr, &p)
+		if err == nil {
+			t.Fatal("expected decode error")
+		}
+
+		if raw.Get("age") != "thirty one" {
+			t.Fatalf("expected raw age to be preserved, got %q", raw.Get("age"))
+		}
+	})
+}
+
+type signup struct {
+	Email string `form:"email"`
+
+	fail bool
+}
+
+func (s *signup) AfterDecode() error {
+	if s.fail {
+		return errors.New("could not normalize")
+	}
+
+	s.Email = strings.ToLower(s.Email)
+	return nil
+}
+
+func TestDecodePostDecode(t *testing.T) {
+	t.Run("calls the hook", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?email=Antonio@Pagano.ID", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var s signup
+		if err := form.Decode(tr, &s); err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Email != "antonio@pagano.id" {
+			t.Fatalf("expected antonio@pagano.id, got %s", s.Email)
+		}
+	})
+
+	t.Run("returns the hook error", func(t *testing.T) {
+		tr, err := http.NewRequest("GET", "/?email=Antonio@Pagano.ID", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := signup{fail: true}
+		err = form.Decode(tr, &s)
+		if err == nil || err.Error() != "could not normalize" {
+			t.Fatalf("expected the hook error, got %v", err)
+		}
+	})
+}
+
+func TestDecodeMaxValuesPerField(t *testing.T) {
+	type filters struct {
+		Tags []string `form:"tags"`
+	}
+
+	request := func(n int) *http.Request {
+		vals := url.Values{}
+		for i := range n {
+			vals.Add("tags", fmt.Sprint(i))
+		}
+
+		tr, err := http.NewRequest("GET", "/?"+vals.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return tr
+	}
+
+	t.Run("under the cap", func(t *testing.T) {
+		var f filters
+		err := form.Decode(request(3), &f, form.WithMaxValuesPerField(3))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(f.Tags) != 3 {
+			t.Fatalf("expected 3 tags, got %v", f.Tags)
+		}
+	})
+
+	t.Run("exceeding the cap", func(t *testing.T) {
+		var f filters
+		err := form.Decode(request(1000), &f, form.WithMaxValuesPerField(3))
+		if !errors.Is(err, form.ErrTooManyValues) {
+			t.Fatalf("expected ErrTooManyValues, got %v", err)
+		}
+
+		if len(f.Tags) != 0 {
+			t.Fatalf("expected tags not to be decoded, got %d", len(f.Tags))
+		}
+	})
+}
+
+func TestDecodeSlice(t *testing.T) {
+	request := func(query string) *http.Request {
+		tr, err := http.NewRequest("GET", "/?"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return tr
+	}
+
+	t.Run("ints", func(t *testing.T) {
+		var ids []int
+		err := form.DecodeSlice(request("ids=3&ids=1&ids=2&other=4"), "ids", &ids)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(ids) != 3 || ids[0] != 3 || ids[1] != 1 || ids[2] != 2 {
+			t.Fatalf("expected [3 1 2], got %v", ids)
+		}
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		var tags []string
+		err := form.DecodeSlice(request("tags=go&tags=web"), "tags", &tags)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(tags) != 2 || tags[0] != "go" || tags[1] != "web" {
+			t.Fatalf("expected [go web], got %v", tags)
+		}
+	})
+
+	t.Run("uuids", func(t *testing.T) {
+		var ids []uuid.UUID
+		err := form.DecodeSlice(request("ids=6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "ids", &ids)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(ids) != 1 || ids[0].String() != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+			t.Fatalf("expected the uuid to be decoded, got %v", ids)
+		}
+	})
+
+	t.Run("conversion errors", func(t *testing.T) {
+		var ids []int
+		err := form.DecodeSlice(request("ids=1&ids=two"), "ids", &ids)
+		if err == nil {
+			t.Fatal("expected a conversion error")
+		}
+	})
+
+	t.Run("not a slice", func(t *testing.T) {
+		var id int
+		err := form.DecodeSlice(request("ids=1"), "ids", &id)
+		if err == nil {
+			t.Fatal("expected an error for a non slice destination")
+		}
+
+		var ids []int
+		err = form.DecodeSlice(request("ids=1"), "ids", ids)
+		if err == nil {
+			t.Fatal("expected an error for a non pointer destination")
+		}
+	})
+}
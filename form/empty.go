@@ -0,0 +1,55 @@
+package form
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// emptyAsNil sets to nil the pointer fields within v whose values
+// in the form are all empty, prefix is the namespace of v in the form.
+func emptyAsNil(v reflect.Value, form url.Values, prefix string) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		fv := v.Field(i)
+
+		if field.Anonymous {
+			emptyAsNil(fv, form, prefix)
+		}
+
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+
+		key := prefix + name
+		if fv.Kind() == reflect.Pointer && fv.CanSet() && isEmpty(form, key) {
+			fv.Set(reflect.Zero(fv.Type()))
+			continue
+		}
+
+		emptyAsNil(fv, form, key+".")
+	}
+}
+
+// isEmpty returns true when the key is in the form
+// and all of its values are blank.
+func isEmpty(form url.Values, key string) bool {
+	values, ok := form[key]
+	if !ok {
+		return false
+	}
+
+	for _, val := range values {
+		if strings.TrimSpace(val) != "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,30 @@
+package form
+
+import (
+	"reflect"
+	"strings"
+)
+
+// fieldName returns the name the decoder uses for the struct field,
+// which is the name in the form tag or the field name. It returns false
+// for the fields the decoder ignores.
+func fieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() && !field.Anonymous {
+		return "", false
+	}
+
+	name := field.Tag.Get("form")
+	if name == "-" {
+		return "", false
+	}
+
+	if idx := strings.LastIndexByte(name, ','); idx != -1 {
+		name = name[:idx]
+	}
+
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, true
+}
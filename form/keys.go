@@ -0,0 +1,75 @@
+package form
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// matchKeys returns a copy of the form where the keys that match a field
+// of dst ignoring case are renamed to the key the decoder expects. Keys
+// that already match exactly take precedence.
+func matchKeys(dst interface{}, form url.Values) url.Values {
+	keys := map[string]string{}
+	structKeys(reflect.TypeOf(dst), "", keys, map[reflect.Type]bool{})
+
+	values := make(url.Values, len(form))
+	for key, vals := range form {
+		values[key] = vals
+	}
+
+	for key, vals := range form {
+		// Indexes are kept as sent, e.g. tags[0].
+		base, rest := key, ""
+		if idx := strings.IndexByte(key, '['); idx != -1 {
+			base, rest = key[:idx], key[idx:]
+		}
+
+		expected, ok := keys[strings.ToLower(base)]
+		if !ok || expected+rest == key {
+			continue
+		}
+
+		if _, exists := form[expected+rest]; exists {
+			continue
+		}
+
+		values[expected+rest] = vals
+	}
+
+	return values
+}
+
+// structKeys adds the form keys of the fields within t to keys,
+// indexed by their lowercase version.
+func structKeys(t reflect.Type, prefix string, keys map[string]string, visited map[reflect.Type]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || visited[t] {
+		return
+	}
+
+	visited[t] = true
+	defer delete(visited, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			structKeys(field.Type, prefix, keys, visited)
+		}
+
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+
+		key := prefix + name
+		if _, exists := keys[strings.ToLower(key)]; !exists {
+			keys[strings.ToLower(key)] = key
+		}
+
+		structKeys(field.Type, key+".", keys, visited)
+	}
+}
@@ -0,0 +1,51 @@
+package form
+
+// Option allows to customize how Decode decodes the request values.
+type Option func(*decodeOptions)
+
+// decodeOptions holds the settings applied by the options
+// passed to Decode.
+type decodeOptions struct {
+	trimSpace       bool
+	emptyAsNil      bool
+	caseInsensitive bool
+	maxValues       int
+}
+
+// WithTrimSpace trims the leading and trailing whitespace of the string
+// fields after decoding. Fields where whitespace is meaningful can opt out
+// with the notrim tag option:
+//
+//	Bio string `form:"bio,notrim"`
+func WithTrimSpace() Option {
+	return func(o *decodeOptions) {
+		o.trimSpace = true
+	}
+}
+
+// WithEmptyAsNil leaves the pointer fields nil when their values in the
+// form are empty, this way a field that was not provided can be told apart
+// from one that was provided with an empty or zero value.
+func WithEmptyAsNil() Option {
+	return func(o *decodeOptions) {
+		o.emptyAsNil = true
+	}
+}
+
+// WithCaseInsensitiveKeys matches the form keys with the struct fields
+// ignoring case, so a value sent as "email" is decoded into a field named
+// "Email". Keys that match exactly take precedence.
+func WithCaseInsensitiveKeys() Option {
+	return func(o *decodeOptions) {
+		o.caseInsensitive = true
+	}
+}
+
+// WithMaxValuesPerField makes Decode fail with ErrTooManyValues when a
+// field arrives with more than n values, e.g. a checkbox field flooded
+// with thousands of entries, before decoding them into slices.
+func WithMaxValuesPerField(n int) Option {
+	return func(o *decodeOptions) {
+		o.maxValues = n
+	}
+}
@@ -0,0 +1,47 @@
+package form
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+// Submission carries the decoded value of a form along with the raw
+// submitted values and the validation errors. It's meant to be passed
+// to templates to repopulate forms with what the user typed and show
+// the errors when the validation fails.
+type Submission[T any] struct {
+	Value  T
+	Raw    url.Values
+	Errors validate.Errors
+}
+
+// Valid returns whether the submission has no validation errors.
+func (s *Submission[T]) Valid() bool {
+	return len(s.Errors) == 0
+}
+
+// Get returns the raw value the user submitted for the field.
+//
+//	<input name="email" value="<%= form.Get("email") %>">
+func (s *Submission[T]) Get(field string) string {
+	return s.Raw.Get(field)
+}
+
+// DecodeSubmission decodes the request into a value of type T and validates
+// the submitted values with the passed rules. The raw values are kept even
+// when decoding fails, in which case the submission is returned with the
+// decode error and no validation errors.
+func DecodeSubmission[T any](r *http.Request, rules validator, options ...Option) (*Submission[T], error) {
+	s := &Submission[T]{}
+
+	raw, err := DecodeWithValues(r, &s.Value, options...)
+	s.Raw = raw
+	if err != nil {
+		return s, err
+	}
+
+	s.Errors = rules.Validate(raw)
+	return s, nil
+}
@@ -0,0 +1,88 @@
+package form_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/leapkit/core/form"
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestDecodeSubmission(t *testing.T) {
+	type person struct {
+		Name string `form:"name"`
+		Age  int    `form:"age"`
+	}
+
+	rules := validate.Fields(
+		validate.Field("name", validate.Required()),
+		validate.Field("age", validate.GreaterThanOrEqualTo(18)),
+	)
+
+	newRequest := func(vals url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(vals.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		return req
+	}
+
+	t.Run("valid submission", func(t *testing.T) {
+		s, err := form.DecodeSubmission[person](newRequest(url.Values{
+			"name": {"Antonio"},
+			"age":  {"31"},
+		}), rules)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !s.Valid() {
+			t.Fatalf("expected no errors, got %v", s.Errors)
+		}
+
+		if s.Value.Name != "Antonio" || s.Value.Age != 31 {
+			t.Fatalf("expected Antonio 31, got %v", s.Value)
+		}
+	})
+
+	t.Run("failed validation keeps raw values and errors", func(t *testing.T) {
+		s, err := form.DecodeSubmission[person](newRequest(url.Values{
+			"name": {"  "},
+			"age":  {"17"},
+		}), rules)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Valid() || len(s.Errors["name"]) == 0 || len(s.Errors["age"]) == 0 {
+			t.Fatalf("expected errors for name and age, got %v", s.Errors)
+		}
+
+		if s.Get("name") != "  " || s.Get("age") != "17" {
+			t.Fatalf("expected the raw values to be kept, got %v", s.Raw)
+		}
+
+		if s.Value.Age != 17 {
+			t.Fatalf("expected the age to be decoded, got %v", s.Value.Age)
+		}
+	})
+
+	t.Run("decode error keeps raw values", func(t *testing.T) {
+		s, err := form.DecodeSubmission[person](newRequest(url.Values{
+			"name": {"Antonio"},
+			"age":  {"thirty"},
+		}), rules)
+
+		if err == nil {
+			t.Fatal("expected decode error")
+		}
+
+		if s.Get("age") != "thirty" {
+			t.Fatalf("expected the raw age to be kept, got %q", s.Get("age"))
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package form
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// trimSpace trims the strings within v, walking through pointers,
+// slices and nested structs. Struct fields with the notrim tag
+// option are left as they are.
+func trimSpace(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			trimSpace(v.Elem())
+		}
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(strings.TrimSpace(v.String()))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			trimSpace(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() || hasTagOption(field, "notrim") {
+				continue
+			}
+
+			trimSpace(v.Field(i))
+		}
+	}
+}
+
+// hasTagOption returns true when the form tag of the
+// field contains the option after its name.
+func hasTagOption(field reflect.StructField, option string) bool {
+	options := strings.Split(field.Tag.Get("form"), ",")
+	return slices.Contains(options[1:], option)
+}
@@ -18,3 +18,15 @@ func Validate(req *http.Request, rules validator) validate.Errors {
 
 	return rules.Validate(req.Form)
 }
+
+// DecodeAndValidate decodes the request into dst and then validates the
+// submitted values with the passed rules. Validation only runs when the
+// request is decoded, so a decode error is returned with no validation
+// errors.
+func DecodeAndValidate(r *http.Request, dst interface{}, rules validator, options ...Option) (validate.Errors, error) {
+	if err := Decode(r, dst, options...); err != nil {
+		return nil, err
+	}
+
+	return Validate(r, rules), nil
+}
@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"context"
+	"net/url"
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ValidateConcurrent performs the validations like Validate but evaluates
+// the field validations in parallel, with at most as many running at once
+// as CPUs are available. This is useful when rules are expensive, e.g. rules
+// that query a database. The rules of each field still run in order and the
+// errors are aggregated in the same order Validate would. When the context
+// is cancelled the pending validations are skipped and its error returned.
+func (v fieldValidations) ValidateConcurrent(ctx context.Context, form url.Values) (Errors, error) {
+	results := make([][]error, len(v))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(runtime.NumCPU())
+
+	for i, validation := range v {
+		g.Go(func() error {
+			for _, rule := range validation.rules() {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				if err := rule.ValidateForm(validation.Field, form); err != nil {
+					results[i] = append(results[i], err)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	verrs := make(map[string][]error)
+	for i, validation := range v {
+		if len(results[i]) == 0 {
+			continue
+		}
+
+		verrs[validation.Field] = append(verrs[validation.Field], results[i]...)
+	}
+
+	return verrs, nil
+}
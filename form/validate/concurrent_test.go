@@ -0,0 +1,91 @@
+package validate_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestValidateConcurrent(test *testing.T) {
+	// slow simulates an expensive rule such as a database check.
+	slow := validate.ValidatorFn(func(values []string) error {
+		time.Sleep(time.Millisecond)
+		if len(values) == 0 || values[0] == "taken" {
+			return errors.New("value is not available")
+		}
+
+		return nil
+	})
+
+	form := url.Values{}
+	validations := validate.Fields()
+	for i := 0; i < 50; i++ {
+		field := fmt.Sprintf("field_%d", i)
+		if i%3 == 0 {
+			form.Set(field, "taken")
+		} else {
+			form.Set(field, "free")
+		}
+
+		validations = append(validations, validate.Field(field, slow, validate.MaxLength(4)))
+	}
+
+	// Given many fields, Then the errors should match the serial validation.
+	// Run with -race to check the validations don't race.
+	test.Run("matches the serial validation", func(t *testing.T) {
+		// Fields with more than one validation aggregate them in order.
+		validations := append(validations[:len(validations):len(validations)],
+			validate.Field("field_0", validate.AtLeastOne([]string{"field_0", "field_1"})),
+			validate.Field("field_0", validate.Required()),
+		)
+
+		verrs, err := validations.ValidateConcurrent(context.Background(), form)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := validations.Validate(form)
+		if len(verrs) == 0 || !reflect.DeepEqual(verrs.AsMap(), expected.AsMap()) {
+			t.Fatalf("expected %v, got %v", expected, verrs)
+		}
+	})
+
+	// Given a cancelled context, Then the pending validations should be skipped.
+	test.Run("aborts when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var calls atomic.Int32
+		counting := validate.ValidatorFn(func(values []string) error {
+			if calls.Add(1) == 1 {
+				cancel()
+			}
+
+			return nil
+		})
+
+		validations := validate.Fields()
+		for i := 0; i < 100; i++ {
+			validations = append(validations, validate.Field(fmt.Sprintf("field_%d", i), counting, counting))
+		}
+
+		verrs, err := validations.ValidateConcurrent(ctx, form)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+
+		if verrs != nil {
+			t.Fatalf("expected no errors, got %v", verrs)
+		}
+
+		if calls.Load() >= 200 {
+			t.Fatalf("expected validations to be skipped, got %d calls", calls.Load())
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Resolver looks up the addresses of a host, *net.Resolver
+// implements it and stubs can be used in tests.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// ResolvableHost function validates that the values are hostnames that
+// resolve using the default resolver, with a timeout of 5 seconds.
+//
+// This rule is network-dependent: it performs a DNS lookup for each
+// value, which makes validations slower and may fail when the network
+// is unavailable. Use ResolvableHostWith to pass a context, a custom
+// resolver or a different timeout.
+func ResolvableHost(message ...string) ValidatorFn {
+	return ResolvableHostWith(context.Background(), net.DefaultResolver, 5*time.Second, message...)
+}
+
+// ResolvableHostWith function validates that the values are hostnames that
+// resolve with the passed resolver. Each lookup is bound to the context and
+// cancelled after the timeout.
+func ResolvableHostWith(ctx context.Context, resolver Resolver, timeout time.Duration, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			lctx, cancel := context.WithTimeout(ctx, timeout)
+			addrs, err := resolver.LookupHost(lctx, val)
+			cancel()
+
+			if err == nil && len(addrs) > 0 {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' does not resolve to an address.", val), message...)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package validate_test
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+// stubResolver resolves the hosts in the map.
+type stubResolver map[string][]string
+
+func (s stubResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if addrs, ok := s[host]; ok {
+		return addrs, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestRuleResolvableHost(test *testing.T) {
+	resolver := stubResolver{
+		"leapkit.dev": {"192.0.2.10"},
+	}
+
+	validations := validate.Fields(
+		validate.Field("host", validate.ResolvableHostWith(context.Background(), resolver, time.Second)),
+	)
+
+	// Given a form with a host that resolves, Then the ResolvableHost rule should return no error.
+	test.Run("correct form field value resolves", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"host": {"leapkit.dev"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a host that does not resolve, Then the ResolvableHost rule should return error.
+	test.Run("incorrect form field value does not resolve", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"host": {"missing.leapkit.dev"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
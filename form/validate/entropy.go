@@ -0,0 +1,108 @@
+package validate
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// commonPasswordWordBits is what a common password word, e.g.
+// "password" or "qwerty", adds to the estimated entropy.
+const commonPasswordWordBits = 10
+
+// commonPasswordWords are the words predictable passwords are usually
+// built around, longer words go first so these are stripped whole.
+var commonPasswordWords = []string{
+	"password", "letmein", "welcome", "monkey", "dragon", "master", "qwerty",
+	"sunshine", "princess", "football", "baseball", "shadow", "superman",
+	"iloveyou", "trustno1", "admin", "login", "passw0rd", "abc123",
+	"123456", "654321", "111111", "000000", "pass", "love", "secret",
+}
+
+// MinEntropy function validates that the estimated entropy of the values
+// is at least the passed bits, which catches weak passwords that comply
+// with the character class rules, like "Password1!". Each character adds
+// the bits of the pool of the character classes used in the value, while
+// repeated or sequential characters and common password words only add
+// a few bits. The error doesn't include the value.
+//
+//	validate.Field("password", validate.MinEntropy(60))
+func MinEntropy(bits float64, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if entropy(val) < bits {
+				return newError("The password is too predictable, try a longer passphrase.", message...)
+			}
+		}
+
+		return nil
+	}
+}
+
+// entropy estimates the bits of entropy of the password.
+func entropy(password string) float64 {
+	rest := strings.ToLower(password)
+
+	var bits float64
+	for _, word := range commonPasswordWords {
+		n := strings.Count(rest, word)
+		if n == 0 {
+			continue
+		}
+
+		bits += float64(n * commonPasswordWordBits)
+		rest = strings.ReplaceAll(rest, word, "\x00")
+	}
+
+	// The pool is taken from the original password so the
+	// letters case counts even when the words were stripped.
+	perChar := math.Log2(float64(poolSize(password)))
+
+	last := rune(-1)
+	for _, r := range rest {
+		switch {
+		case r == 0:
+			// Stripped words don't add more bits.
+		case r == last, r == last+1, r == last-1:
+			bits++
+		default:
+			bits += perChar
+		}
+
+		last = r
+	}
+
+	return bits
+}
+
+// poolSize returns the number of characters in the
+// classes used by the password.
+func poolSize(password string) int {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	size := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			size += class.size
+		}
+	}
+
+	return max(size, 1)
+}
@@ -0,0 +1,53 @@
+package validate_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestRuleMinEntropy(test *testing.T) {
+	validations := validate.Fields(validate.Field("password", validate.MinEntropy(60)))
+
+	// Given high entropy passwords, Then the validation should pass.
+	test.Run("high entropy", func(t *testing.T) {
+		for _, password := range []string{
+			"correct horse battery staple",
+			"vT9#qLz2!mWx8&Rk",
+		} {
+			if verrs := validations.Validate(url.Values{"password": {password}}); len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors for %q, verrs=%v", password, verrs)
+			}
+		}
+	})
+
+	// Given predictable passwords, Then the validation should fail.
+	test.Run("predictable", func(t *testing.T) {
+		for _, password := range []string{
+			"Password1!",
+			"Qwerty123456!",
+			"aaaaaaaaaaaaaaaaaaaa",
+			"abcdefghijklmnopqrst",
+			"short",
+		} {
+			verrs := validations.Validate(url.Values{"password": {password}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %q. verrs=%v", password, verrs)
+			}
+
+			if msg := verrs["password"][0].Error(); msg != "The password is too predictable, try a longer passphrase." {
+				t.Fatalf("unexpected message %q", msg)
+			}
+		}
+	})
+
+	// Given a custom message, Then it should be used.
+	test.Run("custom message", func(t *testing.T) {
+		validations := validate.Fields(validate.Field("password", validate.MinEntropy(60, "Too weak")))
+		verrs := validations.Validate(url.Values{"password": {"Password1!"}})
+		if len(verrs["password"]) == 0 || verrs["password"][0].Error() != "Too weak" {
+			t.Fatalf("expected the custom message, got %v", verrs)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// RequiredWithout function validates the form field has no-empty values
+// when the other field is empty or absent from the form.
+func RequiredWithout(other string, message ...string) FormValidatorFn {
+	return func(field string, form url.Values) error {
+		if hasValues(form[other]) || Required()(form[field]) == nil {
+			return nil
+		}
+
+		return newError(fmt.Sprintf("This field is required when '%s' is empty.", other), message...)
+	}
+}
+
+// RequiredWith function validates the form field has no-empty values
+// when the other field has a value in the form.
+func RequiredWith(other string, message ...string) FormValidatorFn {
+	return func(field string, form url.Values) error {
+		if !hasValues(form[other]) || Required()(form[field]) == nil {
+			return nil
+		}
+
+		return newError(fmt.Sprintf("This field is required when '%s' is present.", other), message...)
+	}
+}
+
+// RequiredIf function validates the form field has no-empty values when
+// the other field holds the passed value. A missing or empty other field
+// means the condition is not met.
+func RequiredIf(other, value string, message ...string) FormValidatorFn {
+	return func(field string, form url.Values) error {
+		holds := slices.ContainsFunc(form[other], func(val string) bool {
+			return strings.TrimSpace(val) == value
+		})
+
+		if !holds || Required()(form[field]) == nil {
+			return nil
+		}
+
+		return newError(fmt.Sprintf("'%s' is required when '%s' is '%s'.", field, other, value), message...)
+	}
+}
+
+// SameAs function validates that the form field values are the same as
+// the values of the other field, e.g. a password confirmation.
+func SameAs(other string, message ...string) FormValidatorFn {
+	return func(field string, form url.Values) error {
+		if slices.Equal(form[field], form[other]) {
+			return nil
+		}
+
+		return newError(fmt.Sprintf("'%s' must be the same as '%s'.", field, other), message...)
+	}
+}
+
+// GreaterThanFieldBy function validates that the form field values are
+// numbers that exceed the value of the other field by at least delta.
+// The validation is skipped when the other field is empty.
+func GreaterThanFieldBy(other string, delta float64, message ...string) FormValidatorFn {
+	return func(field string, form url.Values) error {
+		if !hasValues(form[other]) {
+			return nil
+		}
+
+		ref, err := strconv.ParseFloat(strings.TrimSpace(form.Get(other)), 64)
+		if err != nil {
+			return newError(fmt.Sprintf("'%s' is not a number.", form.Get(other)), message...)
+		}
+
+		for _, val := range form[field] {
+			n, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+			if err != nil {
+				return newError(fmt.Sprintf("'%s' is not a number.", val), message...)
+			}
+
+			if n-ref >= delta {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must be greater than '%s' by at least %v.", val, other, delta), message...)
+		}
+
+		return nil
+	}
+}
+
+// hasValues returns true when the values contain at least
+// one value that is not blank.
+func hasValues(values []string) bool {
+	return slices.ContainsFunc(values, func(val string) bool {
+		return strings.TrimSpace(val) != ""
+	})
+}
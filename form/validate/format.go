@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// hexColorExp matches colors like "#fff" or "#1a2b3c".
+var hexColorExp = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}){1,2}$`)
+
+// formats are the built-in presets Format resolves by name.
+var formats = map[string]func(message ...string) ValidatorFn{
+	"email": func(message ...string) ValidatorFn {
+		return MatchRegex(emailExp, append(message, "This field must be a valid email address.")[0])
+	},
+	"url":  validURL,
+	"uuid": ValidUUID,
+	"slug": NormalizedSlug,
+	"hexcolor": func(message ...string) ValidatorFn {
+		return MatchRegex(hexColorExp, append(message, "This field must be a valid hex color.")[0])
+	},
+}
+
+// Format function validates the values with the named format preset,
+// which keeps common validations concise and data driven. Rules added
+// with Register are looked up first, then the built-in presets: "email",
+// "url", "uuid", "slug" and "hexcolor". The message overrides the error
+// of both. When the name is neither registered nor a preset the rule
+// fails with an unknown format error, ByName allows to check the name
+// beforehand.
+//
+//	validate.Field("website", validate.Format("url"))
+func Format(name string, message ...string) Rule {
+	registryMut.RLock()
+	rule, ok := registry[name]
+	registryMut.RUnlock()
+
+	if ok {
+		return FormValidatorFn(func(field string, form url.Values) error {
+			err := rule.ValidateForm(field, form)
+			if err != nil {
+				return newError(err.Error(), message...)
+			}
+
+			return nil
+		})
+	}
+
+	if preset, ok := formats[name]; ok {
+		return preset(message...)
+	}
+
+	return ValidatorFn(func([]string) error {
+		return fmt.Errorf("unknown format %q", name)
+	})
+}
+
+// validURL validates that the values are absolute http or https URLs.
+func validURL(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			u, err := url.ParseRequestURI(val)
+			if err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not a valid URL.", val), message...)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+package validate_test
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestFormat(test *testing.T) {
+	// Given the built-in presets, Then Format should validate with the corresponding rule.
+	test.Run("built-in presets", func(t *testing.T) {
+		tcases := []struct {
+			format  string
+			valid   string
+			invalid string
+		}{
+			{"email", "a@pagano.id", "not-an-email"},
+			{"url", "https://leapkit.dev/docs?q=1", "leapkit.dev"},
+			{"uuid", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "1234"},
+			{"slug", "my-post", "My Post"},
+			{"hexcolor", "#1a2B3c", "#12345"},
+		}
+
+		for _, tcase := range tcases {
+			validations := validate.Fields(validate.Field("value", validate.Format(tcase.format)))
+			if verrs := validations.Validate(url.Values{"value": {tcase.valid}}); len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors for %s, verrs=%v", tcase.format, verrs)
+			}
+
+			if verrs := validations.Validate(url.Values{"value": {tcase.invalid}}); len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", tcase.format, verrs)
+			}
+		}
+	})
+
+	// Given a custom message, Then Format should use it for the presets.
+	test.Run("custom message", func(t *testing.T) {
+		validations := validate.Fields(validate.Field("color", validate.Format("hexcolor", "Pick a color")))
+		verrs := validations.Validate(url.Values{"color": {"red"}})
+		if len(verrs["color"]) == 0 || verrs["color"][0].Error() != "Pick a color" {
+			t.Fatalf("expected the custom message, got %v", verrs)
+		}
+	})
+
+	// Given a registered rule, Then Format should resolve it.
+	test.Run("registered format", func(t *testing.T) {
+		validate.Register("zip5", validate.ValidatorFn(func(values []string) error {
+			for _, val := range values {
+				if len(val) != 5 || strings.Trim(val, "0123456789") != "" {
+					return errors.New("must be a 5 digit zip code")
+				}
+			}
+
+			return nil
+		}))
+
+		validations := validate.Fields(validate.Field("zip", validate.Format("zip5")))
+		if verrs := validations.Validate(url.Values{"zip": {"90210"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"zip": {"9021"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a custom message, Then Format should use it for the registered rules too.
+	test.Run("registered format custom message", func(t *testing.T) {
+		validations := validate.Fields(validate.Field("zip", validate.Format("zip5", "Enter your zip code")))
+		verrs := validations.Validate(url.Values{"zip": {"9021"}})
+		if len(verrs["zip"]) == 0 || verrs["zip"][0].Error() != "Enter your zip code" {
+			t.Fatalf("expected the custom message, got %v", verrs)
+		}
+	})
+
+	// Given an unknown format, Then Format should return an error naming it.
+	test.Run("unknown format", func(t *testing.T) {
+		validations := validate.Fields(validate.Field("email", validate.Format("emial", "Enter your email")))
+		verrs := validations.Validate(url.Values{"email": {"a@pagano.id"}})
+		if len(verrs["email"]) == 0 || verrs["email"][0].Error() != `unknown format "emial"` {
+			t.Fatalf("expected the unknown format error, got %v", verrs)
+		}
+	})
+
+	// Given a format preset name, Then ByName should resolve it as well.
+	test.Run("by name", func(t *testing.T) {
+		if _, err := validate.ByName("hexcolor"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AtLeastOne function validates that at least one of the passed fields
+// has a value. It's a form level rule, so it's added under a name for the
+// group which is the key its error is reported under:
+//
+//	validate.Fields(
+//		validate.Field("name", validate.Required()),
+//		validate.Field("contact", validate.AtLeastOne([]string{"email", "phone"})),
+//	)
+func AtLeastOne(fields []string, message ...string) Rule {
+	return groupRule{
+		fields: fields,
+		check: func(form url.Values) error {
+			if filled(form, fields) > 0 {
+				return nil
+			}
+
+			return newError(fmt.Sprintf("At least one of %s is required.", strings.Join(fields, ", ")), message...)
+		},
+	}
+}
+
+// MutuallyExclusive function validates that no more than one of the
+// passed fields has a value. Like AtLeastOne, it's a form level rule
+// added under a name for the group.
+//
+//	validate.Field("discount", validate.MutuallyExclusive([]string{"coupon_code", "gift_card"}))
+func MutuallyExclusive(fields []string, message ...string) Rule {
+	return groupRule{
+		fields: fields,
+		check: func(form url.Values) error {
+			if filled(form, fields) <= 1 {
+				return nil
+			}
+
+			return newError(fmt.Sprintf("Only one of %s can be provided.", strings.Join(fields, ", ")), message...)
+		},
+	}
+}
+
+// groupRule is a rule that spans multiple fields, ValidatePresent
+// runs it when any of these fields is present in the form.
+type groupRule struct {
+	fields []string
+	check  func(url.Values) error
+}
+
+// ValidateForm runs the check with the whole form.
+func (g groupRule) ValidateForm(_ string, form url.Values) error {
+	return g.check(form)
+}
+
+// present returns whether any of the fields of the group is in the form.
+func (g groupRule) present(form url.Values) bool {
+	for _, field := range g.fields {
+		if _, ok := form[field]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filled returns the number of the passed fields that have a value.
+func filled(form url.Values, fields []string) int {
+	var count int
+	for _, field := range fields {
+		if hasValues(form[field]) {
+			count++
+		}
+	}
+
+	return count
+}
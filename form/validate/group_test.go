@@ -0,0 +1,131 @@
+package validate_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestAtLeastOne(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("name", validate.Required()),
+		validate.Field("contact", validate.AtLeastOne([]string{"email", "phone"})),
+	)
+
+	// Given a form with none of the fields filled, Then AtLeastOne should return error under the group name.
+	test.Run("no field filled", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"name":  {"Antonio"},
+			"email": {""},
+		})
+
+		if len(verrs["contact"]) == 0 {
+			t.Fatalf("verrs should have errors for contact. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with one of the fields filled, Then AtLeastOne should return no error.
+	test.Run("one field filled", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"name":  {"Antonio"},
+			"phone": {"555-0100"},
+		})
+
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a partial form clearing the second field, Then ValidatePresent should run AtLeastOne.
+	test.Run("validate present", func(t *testing.T) {
+		verrs := validations.ValidatePresent(url.Values{"phone": {""}})
+		if len(verrs["contact"]) == 0 {
+			t.Fatalf("verrs should have errors for contact. verrs=%v", verrs)
+		}
+
+		verrs = validations.ValidatePresent(url.Values{"other": {"x"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a custom message, Then AtLeastOne should use it.
+	test.Run("custom message", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("contact", validate.AtLeastOne([]string{"email", "phone"}, "Tell us how to reach you")),
+		)
+
+		verrs := validations.Validate(url.Values{})
+		if len(verrs["contact"]) == 0 || verrs["contact"][0].Error() != "Tell us how to reach you" {
+			t.Fatalf("expected the custom message, got %v", verrs)
+		}
+	})
+}
+
+func TestMutuallyExclusive(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("discount", validate.MutuallyExclusive([]string{"coupon_code", "gift_card"})),
+	)
+
+	// Given a form with none of the fields filled, Then MutuallyExclusive should return no error.
+	test.Run("no field filled", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with one of the fields filled, Then MutuallyExclusive should return no error.
+	test.Run("one field filled", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"coupon_code": {"SAVE10"},
+			"gift_card":   {" "},
+		})
+
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with both fields filled, Then MutuallyExclusive should return error.
+	test.Run("two fields filled", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"coupon_code": {"SAVE10"},
+			"gift_card":   {"GC-1234"},
+		})
+
+		if len(verrs["discount"]) == 0 {
+			t.Fatalf("verrs should have errors for discount. verrs=%v", verrs)
+		}
+	})
+
+	// Given a partial form with only the second field, Then ValidatePresent should run MutuallyExclusive.
+	test.Run("validate present", func(t *testing.T) {
+		verrs := validations.ValidatePresent(url.Values{"gift_card": {"GC-1234"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		verrs = validations.ValidatePresent(url.Values{
+			"coupon_code": {"SAVE10"},
+			"gift_card":   {"GC-1234"},
+		})
+
+		if len(verrs["discount"]) == 0 {
+			t.Fatalf("verrs should have errors for discount. verrs=%v", verrs)
+		}
+	})
+
+	// Given a custom message, Then MutuallyExclusive should use it.
+	test.Run("custom message", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("discount", validate.MutuallyExclusive([]string{"coupon_code", "gift_card"}, "Pick one discount")),
+		)
+
+		verrs := validations.Validate(url.Values{"coupon_code": {"SAVE10"}, "gift_card": {"GC-1234"}})
+		if len(verrs["discount"]) == 0 || verrs["discount"][0].Error() != "Pick one discount" {
+			t.Fatalf("expected the custom message, got %v", verrs)
+		}
+	})
+}
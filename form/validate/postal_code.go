@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	postalMut sync.RWMutex
+
+	// postalCodes holds the postal code formats by ISO 3166-1 alpha-2
+	// country code, more formats can be added with RegisterPostalCode.
+	postalCodes = map[string]*regexp.Regexp{
+		"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		"CA": regexp.MustCompile(`^(?i)[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`),
+		"GB": regexp.MustCompile(`^(?i)[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+		"UK": regexp.MustCompile(`^(?i)[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+		"AU": regexp.MustCompile(`^\d{4}$`),
+		"BR": regexp.MustCompile(`^\d{5}-?\d{3}$`),
+		"CO": regexp.MustCompile(`^\d{6}$`),
+		"DE": regexp.MustCompile(`^\d{5}$`),
+		"ES": regexp.MustCompile(`^\d{5}$`),
+		"FR": regexp.MustCompile(`^\d{5}$`),
+		"IN": regexp.MustCompile(`^\d{6}$`),
+		"IT": regexp.MustCompile(`^\d{5}$`),
+		"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+		"MX": regexp.MustCompile(`^\d{5}$`),
+		"NL": regexp.MustCompile(`^(?i)\d{4} ?[A-Z]{2}$`),
+	}
+
+	// loosePostalCode is used for countries without a registered format.
+	loosePostalCode = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 -]{1,8}[A-Za-z0-9]$`)
+)
+
+// RegisterPostalCode sets the postal code format for a country, this
+// allows to add countries or to replace the built-in formats.
+func RegisterPostalCode(country string, re *regexp.Regexp) {
+	postalMut.Lock()
+	defer postalMut.Unlock()
+
+	postalCodes[strings.ToUpper(country)] = re
+}
+
+// PostalCode function validates that the values are postal codes for the
+// given country code (e.g. "US", "CA", "GB"). Countries without a registered
+// format are validated with a loose alphanumeric pattern.
+func PostalCode(country string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		postalMut.RLock()
+		re, ok := postalCodes[strings.ToUpper(country)]
+		postalMut.RUnlock()
+
+		if !ok {
+			re = loosePostalCode
+		}
+
+		for _, val := range values {
+			if re.MatchString(val) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not a valid postal code.", val), message...)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,39 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// compiled caches the expressions compiled by MatchRegexString by pattern.
+var compiled sync.Map
+
+// MatchRegexString function validates the form field values with the
+// regular expression in the pattern. Patterns are compiled once and
+// cached, so validations can be built on each request without paying
+// the compilation cost. It returns an error if the pattern is invalid.
+func MatchRegexString(pattern string, message ...string) (ValidatorFn, error) {
+	re, err := compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return MatchRegex(re, message...), nil
+}
+
+// compile returns the cached expression for the
+// pattern, compiling it the first time.
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := compiled.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("validate: invalid pattern %q: %w", pattern, err)
+	}
+
+	actual, _ := compiled.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp), nil
+}
@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestMatchRegexString(test *testing.T) {
+	// Given the same pattern twice, Then the compiled expression should be reused.
+	test.Run("reuses the compiled expression", func(t *testing.T) {
+		a, err := compile(`^[a-z]+-\d+$`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := compile(`^[a-z]+-\d+$`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a != b {
+			t.Fatal("expected the compiled expression to be reused")
+		}
+	})
+
+	// Given a valid pattern, Then the rule should validate the values.
+	test.Run("validates the values", func(t *testing.T) {
+		rule, err := MatchRegexString(`^[a-z]+-\d+$`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		validations := Fields(Field("code", rule))
+		if verrs := validations.Validate(url.Values{"code": {"abc-123"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"code": {"ABC"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given an invalid pattern, Then MatchRegexString should return error.
+	test.Run("invalid pattern", func(t *testing.T) {
+		if _, err := MatchRegexString(`^[a-z+$`); err == nil {
+			t.Fatal("expected an error for the invalid pattern")
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package validate
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	registryMut sync.RWMutex
+	registry    = map[string]Rule{}
+)
+
+// Register adds a rule to the registry under the passed name so
+// it can be referenced by name, e.g. when building validations
+// from configuration. Registering a name again replaces the rule.
+func Register(name string, rule Rule) {
+	registryMut.Lock()
+	defer registryMut.Unlock()
+
+	registry[name] = rule
+}
+
+// ByName returns the rule registered with the passed name. When no rule
+// is registered with that name it falls back to the built-in rules that
+// take no arguments such as "required" or "email", and the format
+// presets such as "url" or "hexcolor".
+func ByName(name string) (Rule, error) {
+	registryMut.RLock()
+	rule, ok := registry[name]
+	registryMut.RUnlock()
+
+	if ok {
+		return rule, nil
+	}
+
+	switch name {
+	case "required", "email", "uuid", "integer":
+		return tagRule(name)
+	}
+
+	if preset, ok := formats[name]; ok {
+		return preset(), nil
+	}
+
+	return nil, fmt.Errorf("unknown rule %q", name)
+}
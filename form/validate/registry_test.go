@@ -0,0 +1,58 @@
+package validate_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestRegistry(test *testing.T) {
+	validate.Register("no_admin", validate.ValidatorFn(func(values []string) error {
+		for _, val := range values {
+			if val == "admin" {
+				return errors.New("admin is reserved")
+			}
+		}
+
+		return nil
+	}))
+
+	// Given a registered rule, Then ByName should resolve it.
+	test.Run("registered rule", func(t *testing.T) {
+		rule, err := validate.ByName("no_admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		validations := validate.Fields(validate.Field("username", rule))
+		if verrs := validations.Validate(url.Values{"username": {"admin"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"username": {"antonio"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a built-in rule name, Then ByName should resolve it.
+	test.Run("built-in rule", func(t *testing.T) {
+		rule, err := validate.ByName("required")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		validations := validate.Fields(validate.Field("username", rule))
+		if verrs := validations.Validate(url.Values{}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given an unknown name, Then ByName should return error.
+	test.Run("unknown rule", func(t *testing.T) {
+		if _, err := validate.ByName("unknown"); err == nil {
+			t.Fatal("ByName should return an error for unknown rules")
+		}
+	})
+}
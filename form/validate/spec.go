@@ -0,0 +1,63 @@
+package validate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Parse builds validations from a JSON or YAML spec that maps each field
+// to the list of rules it should follow. Rules use the same syntax as the
+// `validate` struct tag, and rules without arguments can also reference
+// the ones added with Register:
+//
+//	name: [required, maxlen=50]
+//	email: [required, email]
+//	age: [min=18, max=120]
+//	role: ["oneof=admin user"]
+//	password: [required, strong_password]
+//
+// Fields are validated in alphabetical order.
+func Parse(spec []byte) (fieldValidations, error) {
+	// YAML is a superset of JSON so both formats are decoded the same way.
+	var fields map[string][]string
+	if err := yaml.Unmarshal(spec, &fields); err != nil {
+		return nil, fmt.Errorf("validate: parsing spec: %w", err)
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	var validations fieldValidations
+	for _, name := range names {
+		var rules []Rule
+		for _, token := range fields[name] {
+			rule, err := specRule(strings.TrimSpace(token))
+			if err != nil {
+				return nil, fmt.Errorf("validate: field %s: %w", name, err)
+			}
+
+			rules = append(rules, rule)
+		}
+
+		validations = append(validations, Field(name, rules...))
+	}
+
+	return validations, nil
+}
+
+// specRule returns the rule for a spec token, tokens without
+// arguments are looked up in the registry first.
+func specRule(token string) (Rule, error) {
+	if !strings.Contains(token, "=") {
+		return ByName(token)
+	}
+
+	return tagRule(token)
+}
@@ -0,0 +1,84 @@
+package validate_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("json spec", func(t *testing.T) {
+		spec := `{
+			"name": ["required", "maxlen=5"],
+			"age": ["min=18"],
+			"role": ["oneof=admin user"]
+		}`
+
+		validations, err := validate.Parse([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		verrs := validations.Validate(url.Values{
+			"name": {"Antonio"},
+			"age":  {"17"},
+			"role": {"user"},
+		})
+
+		if len(verrs) != 2 {
+			t.Fatalf("verrs should have 2 errors, verrs=%v", verrs)
+		}
+
+		if _, ok := verrs["name"]; !ok {
+			t.Fatalf("verrs should have errors for name, verrs=%v", verrs)
+		}
+
+		if _, ok := verrs["age"]; !ok {
+			t.Fatalf("verrs should have errors for age, verrs=%v", verrs)
+		}
+	})
+
+	t.Run("yaml spec", func(t *testing.T) {
+		spec := "email: [required, email]\nrole: [\"oneof=admin user\"]\n"
+
+		validations, err := validate.Parse([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		verrs := validations.Validate(url.Values{
+			"email": {"a@leapkit.dev"},
+			"role":  {"admin"},
+		})
+
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	t.Run("registered rule", func(t *testing.T) {
+		validate.Register("short", validate.MaxLength(3))
+
+		validations, err := validate.Parse([]byte(`{"code": ["short"]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if verrs := validations.Validate(url.Values{"code": {"ABCD"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors, verrs=%v", verrs)
+		}
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		if _, err := validate.Parse([]byte(`{"name": ["unknown"]}`)); err == nil {
+			t.Fatal("Parse should return an error for unknown rules")
+		}
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		if _, err := validate.Parse([]byte(`{"age": ["min=ten"]}`)); err == nil {
+			t.Fatal("Parse should return an error for invalid arguments")
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// emailExp is the expression used by the email struct tag rule.
+var emailExp = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// ValidateStruct validates the fields of the struct v points to using the
+// rules declared in their `validate` tag, rules are separated by commas and
+// map to the rule functions in this package:
+//
+//	type User struct {
+//		Name  string `form:"name" validate:"required,maxlen=50"`
+//		Email string `form:"email" validate:"required,email"`
+//		Age   int    `form:"age" validate:"min=18,max=120"`
+//		Role  string `form:"role" validate:"oneof=admin user"`
+//	}
+//
+// Errors are keyed by the name in the `form` tag of the field or the field
+// name when it has none. Nil pointers and empty strings or slices are
+// considered as missing values, other zero values like 0 are validated.
+// It panics if a tag contains an unknown rule or an invalid argument.
+func ValidateStruct(v any) Errors {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validate: ValidateStruct expects a struct, got %T", v))
+	}
+
+	form := url.Values{}
+	var validations fieldValidations
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag := sf.Tag.Get("validate")
+		if !sf.IsExported() || tag == "" || tag == "-" {
+			continue
+		}
+
+		name := sf.Name
+		if fname, _, _ := strings.Cut(sf.Tag.Get("form"), ","); fname != "" && fname != "-" {
+			name = fname
+		}
+
+		var rules []Rule
+		for _, token := range strings.Split(tag, ",") {
+			rule, err := tagRule(strings.TrimSpace(token))
+			if err != nil {
+				panic(fmt.Sprintf("validate: field %s: %v", sf.Name, err))
+			}
+
+			rules = append(rules, rule)
+		}
+
+		form[name] = structValues(rv.Field(i))
+		validations = append(validations, Field(name, rules...))
+	}
+
+	return validations.Validate(form)
+}
+
+// tagRule returns the rule for a struct tag token such as
+// "required" or "min=10".
+func tagRule(token string) (ValidatorFn, error) {
+	name, arg, _ := strings.Cut(token, "=")
+
+	number := func() (float64, error) {
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return 0, fmt.Errorf("rule %q expects a number, got %q", name, arg)
+		}
+
+		return n, nil
+	}
+
+	length := func() (int, error) {
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return 0, fmt.Errorf("rule %q expects an integer, got %q", name, arg)
+		}
+
+		return n, nil
+	}
+
+	switch name {
+	case "required":
+		return Required(), nil
+	case "email":
+		return MatchRegex(emailExp, "This field must be a valid email address."), nil
+	case "uuid":
+		return ValidUUID(), nil
+	case "integer":
+		return Integer(), nil
+	case "oneof":
+		return WithinOptions(strings.Fields(arg)), nil
+	case "eq", "lt", "lte", "gt", "gte", "min", "max":
+		n, err := number()
+		if err != nil {
+			return nil, err
+		}
+
+		switch name {
+		case "eq":
+			return EqualTo(n), nil
+		case "lt":
+			return LessThan(n), nil
+		case "gt":
+			return GreaterThan(n), nil
+		case "gte", "min":
+			return GreaterThanOrEqualTo(n), nil
+		default:
+			return LessThanOrEqualTo(n), nil
+		}
+	case "minlen", "maxlen":
+		n, err := length()
+		if err != nil {
+			return nil, err
+		}
+
+		if name == "minlen" {
+			return MinLength(n), nil
+		}
+
+		return MaxLength(n), nil
+	}
+
+	return nil, fmt.Errorf("unknown rule %q", name)
+}
+
+// structValues converts a struct field into the form values the rules
+// validate. Nil pointers and empty strings or slices return no values,
+// other zero values like 0 or false are validated as they are.
+func structValues(rv reflect.Value) []string {
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		if rv.Len() == 0 {
+			return nil
+		}
+	case reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+	}
+
+	// Types like uuid.UUID are arrays that know how to print themselves.
+	if s, ok := rv.Interface().(fmt.Stringer); ok {
+		return []string{s.String()}
+	}
+
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		var values []string
+		for i := 0; i < rv.Len(); i++ {
+			values = append(values, fmt.Sprint(rv.Index(i).Interface()))
+		}
+
+		return values
+	}
+
+	return []string{fmt.Sprint(rv.Interface())}
+}
@@ -0,0 +1,101 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestValidateStruct(test *testing.T) {
+	type user struct {
+		Name   string    `form:"name" validate:"required"`
+		Email  string    `form:"email" validate:"required,email"`
+		Age    int       `form:"age" validate:"min=18,max=120"`
+		ID     uuid.UUID `validate:"uuid"`
+		Ignore string
+	}
+
+	// Given a struct that complies with its tags, Then ValidateStruct should return no error.
+	test.Run("correct struct", func(t *testing.T) {
+		u := user{Name: "Antonio", Email: "a@pagano.id", Age: 30, ID: uuid.Must(uuid.NewV4())}
+
+		verrs := validate.ValidateStruct(&u)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a struct with empty required fields, Then ValidateStruct should return errors keyed by the form name.
+	test.Run("incorrect struct missing required fields", func(t *testing.T) {
+		u := user{Age: 30}
+
+		verrs := validate.ValidateStruct(&u)
+		if len(verrs["name"]) == 0 || len(verrs["email"]) == 0 {
+			t.Fatalf("verrs should have errors for name and email. verrs=%v", verrs)
+		}
+	})
+
+	// Given a struct with an invalid email, Then ValidateStruct should return error.
+	test.Run("incorrect struct email", func(t *testing.T) {
+		u := user{Name: "Antonio", Email: "antonio", Age: 30}
+
+		verrs := validate.ValidateStruct(u)
+		if len(verrs["email"]) == 0 {
+			t.Fatalf("verrs should have errors for email. verrs=%v", verrs)
+		}
+	})
+
+	// Given a struct with numbers out of range, Then ValidateStruct should return error.
+	test.Run("incorrect struct numeric range", func(t *testing.T) {
+		for _, age := range []int{17, 121} {
+			u := user{Name: "Antonio", Email: "a@pagano.id", Age: age}
+
+			verrs := validate.ValidateStruct(&u)
+			if len(verrs["age"]) == 0 {
+				t.Fatalf("verrs should have errors for age %d. verrs=%v", age, verrs)
+			}
+		}
+	})
+
+	// Given a struct with zero values, Then ValidateStruct should run the rules on them.
+	test.Run("incorrect struct zero values", func(t *testing.T) {
+		u := user{Name: "Antonio", Email: "a@pagano.id", Age: 0}
+
+		verrs := validate.ValidateStruct(&u)
+		if len(verrs["age"]) == 0 {
+			t.Fatalf("verrs should have errors for age 0. verrs=%v", verrs)
+		}
+
+		verrs = validate.ValidateStruct(&struct {
+			Count   int      `validate:"min=1"`
+			Missing *int     `validate:"min=1"`
+			Tags    []string `validate:"required"`
+		}{})
+
+		if len(verrs["Count"]) == 0 {
+			t.Fatalf("verrs should have errors for Count. verrs=%v", verrs)
+		}
+
+		if len(verrs["Missing"]) > 0 {
+			t.Fatalf("verrs must not have errors for a nil pointer, verrs=%v", verrs)
+		}
+
+		if len(verrs["Tags"]) == 0 {
+			t.Fatalf("verrs should have errors for empty Tags. verrs=%v", verrs)
+		}
+	})
+
+	// Given a struct with an unknown rule, Then ValidateStruct should panic.
+	test.Run("unknown rule", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("ValidateStruct should panic with an unknown rule")
+			}
+		}()
+
+		validate.ValidateStruct(&struct {
+			Name string `validate:"unknown"`
+		}{})
+	})
+}
@@ -1,13 +1,25 @@
 package validate
 
-import "net/url"
+import (
+	"net/url"
+	"slices"
+)
 
-// Field validation specifies the rules for that field.
-func Field(field string, rules ...ValidatorFn) fieldValidation {
-	return fieldValidation{
-		Field:      field,
-		Validators: rules,
+// Field validation specifies the rules for that field. Rules that
+// validate the values of the field are kept in Validators, form-level
+// rules run after them.
+func Field(field string, rules ...Rule) fieldValidation {
+	validation := fieldValidation{Field: field}
+	for _, rule := range rules {
+		if fn, ok := rule.(ValidatorFn); ok {
+			validation.Validators = append(validation.Validators, fn)
+			continue
+		}
+
+		validation.formRules = append(validation.formRules, rule)
 	}
+
+	return validation
 }
 
 // Fields is a convenience method to create a set of field validations.
@@ -15,11 +27,52 @@ func Fields(vals ...fieldValidation) fieldValidations {
 	return fieldValidations(vals)
 }
 
+// Merge combines multiple sets of validations into one, the rules
+// for fields present in more than one set are combined in order.
+func Merge(sets ...fieldValidations) fieldValidations {
+	var merged fieldValidations
+	index := map[string]int{}
+
+	for _, set := range sets {
+		for _, validation := range set {
+			i, ok := index[validation.Field]
+			if !ok {
+				index[validation.Field] = len(merged)
+				merged = append(merged, fieldValidation{
+					Field:      validation.Field,
+					Validators: slices.Clone(validation.Validators),
+					formRules:  slices.Clone(validation.formRules),
+				})
+
+				continue
+			}
+
+			merged[i].Validators = append(merged[i].Validators, validation.Validators...)
+			merged[i].formRules = append(merged[i].formRules, validation.formRules...)
+		}
+	}
+
+	return merged
+}
+
 // fieldValidation is a struct that contains a set of rules
 // that form values must comply with for a specific field.
 type fieldValidation struct {
 	Field      string
 	Validators []ValidatorFn
+
+	// formRules are the rules that need the whole form.
+	formRules []Rule
+}
+
+// rules returns the rules of the validation in the order they run.
+func (v fieldValidation) rules() []Rule {
+	rules := make([]Rule, 0, len(v.Validators)+len(v.formRules))
+	for _, fn := range v.Validators {
+		rules = append(rules, fn)
+	}
+
+	return append(rules, v.formRules...)
 }
 
 type fieldValidations []fieldValidation
@@ -29,8 +82,8 @@ func (v fieldValidations) Validate(form url.Values) Errors {
 	verrs := make(map[string][]error)
 
 	for _, validation := range v {
-		for _, rule := range validation.Validators {
-			err := rule(form[validation.Field])
+		for _, rule := range validation.rules() {
+			err := rule.ValidateForm(validation.Field, form)
 			if err == nil {
 				continue
 			}
@@ -42,9 +95,66 @@ func (v fieldValidations) Validate(form url.Values) Errors {
 	return verrs
 }
 
+// ValidatePresent performs the validations only for the fields present in
+// the form, this is useful for partial updates where absent fields should
+// be left as they are. Group rules like AtLeastOne run when any of the
+// fields they span is present.
+func (v fieldValidations) ValidatePresent(form url.Values) Errors {
+	var present fieldValidations
+	for _, validation := range v {
+		if _, ok := form[validation.Field]; ok || validation.spansPresent(form) {
+			present = append(present, validation)
+		}
+	}
+
+	return present.Validate(form)
+}
+
+// spansPresent returns whether any of the group rules of the
+// validation spans a field present in the form.
+func (v fieldValidation) spansPresent(form url.Values) bool {
+	return slices.ContainsFunc(v.formRules, func(rule Rule) bool {
+		g, ok := rule.(groupRule)
+		return ok && g.present(form)
+	})
+}
+
 // Errors is a convenience field to map the form field name to the error message.
 type Errors map[string][]error
 
+// AsMap returns the error messages by field, this is useful
+// to serialize the errors in JSON responses.
+func (e Errors) AsMap() map[string][]string {
+	m := make(map[string][]string, len(e))
+	for field, errs := range e {
+		for _, err := range errs {
+			m[field] = append(m[field], err.Error())
+		}
+	}
+
+	return m
+}
+
+// Rule is a condition a form field must satisfy, both ValidatorFn
+// and FormValidatorFn implement it.
+type Rule interface {
+	ValidateForm(field string, form url.Values) error
+}
+
 // ValidatorFn is a condition that must be satisfied by all values in a specific form field.
 // Otherwise the rule will return an error
 type ValidatorFn func([]string) error
+
+// ValidateForm runs the validator with the values of the field.
+func (fn ValidatorFn) ValidateForm(field string, form url.Values) error {
+	return fn(form[field])
+}
+
+// FormValidatorFn is a condition that receives the whole form, this allows
+// rules to validate a field depending on the values of other fields.
+type FormValidatorFn func(field string, form url.Values) error
+
+// ValidateForm runs the validator with the field name and the form.
+func (fn FormValidatorFn) ValidateForm(field string, form url.Values) error {
+	return fn(field, form)
+}
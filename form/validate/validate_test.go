@@ -0,0 +1,173 @@
+package validate_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+func TestMerge(test *testing.T) {
+	base := validate.Fields(
+		validate.Field("email", validate.Required()),
+		validate.Field("name", validate.Required()),
+	)
+
+	feature := validate.Fields(
+		validate.Field("email", validate.MaxLength(10)),
+		validate.Field("role", validate.WithinOptions([]string{"admin", "user"})),
+	)
+
+	validations := validate.Merge(base, feature)
+
+	// Given merged sets, Then the rules of all sets should be enforced for a shared field.
+	test.Run("shared field enforces all rules", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"email": []string{""},
+			"name":  []string{"Antonio"},
+		})
+
+		if len(verrs["email"]) != 1 {
+			t.Fatalf("email should have the required error. verrs=%v", verrs)
+		}
+
+		verrs = validations.Validate(url.Values{
+			"email": []string{"antonio@pagano.id"},
+			"name":  []string{"Antonio"},
+		})
+
+		if len(verrs["email"]) != 1 {
+			t.Fatalf("email should have the max length error. verrs=%v", verrs)
+		}
+	})
+
+	// Given merged sets, Then the rules of fields from each set should be enforced.
+	test.Run("fields from each set are validated", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"email": []string{"a@b.co"},
+			"role":  []string{"guest"},
+		})
+
+		if len(verrs["name"]) == 0 || len(verrs["role"]) == 0 {
+			t.Fatalf("verrs should have errors for name and role. verrs=%v", verrs)
+		}
+	})
+
+	// Given merged sets, Then the original sets should not be modified.
+	test.Run("original sets are not modified", func(t *testing.T) {
+		verrs := base.Validate(url.Values{
+			"email": []string{"antonio@pagano.id"},
+			"name":  []string{"Antonio"},
+		})
+
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+}
+
+func TestField(test *testing.T) {
+	// Given value and form-level rules, Then only the value rules should be listed in Validators.
+	test.Run("form rules are not listed in validators", func(t *testing.T) {
+		validation := validate.Field("email", validate.Required(), validate.RequiredWithout("phone"))
+
+		var validators []validate.ValidatorFn = validation.Validators
+		if len(validators) != 1 {
+			t.Fatalf("validators should only have the required rule. validators=%d", len(validators))
+		}
+	})
+
+	// Given value and form-level rules, Then all the rules should be enforced.
+	test.Run("form rules are enforced", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("email", validate.MaxLength(3), validate.RequiredWithout("phone")),
+		)
+
+		verrs := validations.Validate(url.Values{"email": {"invalid"}})
+		if len(verrs["email"]) != 1 {
+			t.Fatalf("email should have the max length error. verrs=%v", verrs)
+		}
+
+		verrs = validations.Validate(url.Values{})
+		if len(verrs["email"]) != 1 {
+			t.Fatalf("email should have the required without error. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestErrorsAsMap(t *testing.T) {
+	validations := validate.Fields(
+		validate.Field("name", validate.Required("name is required"), validate.MinLength(3, "name is too short")),
+		validate.Field("email", validate.Required("email is required")),
+		validate.Field("age", validate.Required("age is required")),
+	)
+
+	verrs := validations.Validate(url.Values{
+		"name": []string{""},
+		"age":  []string{"30"},
+	})
+
+	m := verrs.AsMap()
+	if len(m) != 2 {
+		t.Fatalf("expected 2 fields, got %v", m)
+	}
+
+	if !slices.Equal(m["name"], []string{"name is required", "name is too short"}) {
+		t.Errorf("unexpected name messages %v", m["name"])
+	}
+
+	if !slices.Equal(m["email"], []string{"email is required"}) {
+		t.Errorf("unexpected email messages %v", m["email"])
+	}
+
+	bb, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"email":["email is required"],"name":["name is required","name is too short"]}`
+	if string(bb) != expected {
+		t.Errorf("expected %s, got %s", expected, bb)
+	}
+}
+
+func TestValidatePresent(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("name", validate.Required()),
+		validate.Field("email", validate.Required(), validate.MinLength(5)),
+	)
+
+	form := url.Values{
+		"email": []string{"a@b"},
+	}
+
+	// Given a form without some fields, Then ValidatePresent should skip their rules.
+	test.Run("absent fields are skipped", func(t *testing.T) {
+		verrs := validations.ValidatePresent(form)
+		if len(verrs["name"]) > 0 {
+			t.Fatalf("name should not be validated. verrs=%v", verrs)
+		}
+
+		if len(verrs["email"]) == 0 {
+			t.Fatalf("email should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given the same form, Then Validate should validate all the fields.
+	test.Run("validate checks all fields", func(t *testing.T) {
+		verrs := validations.Validate(form)
+		if len(verrs["name"]) == 0 || len(verrs["email"]) == 0 {
+			t.Fatalf("verrs should have errors for name and email. verrs=%v", verrs)
+		}
+	})
+
+	// Given a present but empty field, Then ValidatePresent should run its rules.
+	test.Run("present empty fields are validated", func(t *testing.T) {
+		verrs := validations.ValidatePresent(url.Values{"name": []string{""}})
+		if len(verrs["name"]) == 0 {
+			t.Fatalf("name should have errors. verrs=%v", verrs)
+		}
+	})
+}
@@ -2,13 +2,20 @@ package validate
 
 import (
 	"cmp"
+	"crypto/subtle"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"os"
+	"path"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/gofrs/uuid/v5"
 )
@@ -28,6 +35,45 @@ func Required(message ...string) ValidatorFn {
 	}
 }
 
+// Accepted function validates that the form field is a checked checkbox,
+// its values must be one of "on", "true", "1" or "yes".
+func Accepted(message ...string) ValidatorFn {
+	return func(values []string) error {
+		accepted := len(values) > 0 && !slices.ContainsFunc(values, func(val string) bool {
+			return !slices.Contains([]string{"on", "true", "1", "yes"}, strings.ToLower(strings.TrimSpace(val)))
+		})
+
+		if accepted {
+			return nil
+		}
+
+		return newError("This field must be accepted.", message...)
+	}
+}
+
+// EqualToEnv function validates that the values are equal to the value of
+// the environment variable, which is read when validating. Values are
+// compared in constant time and a missing variable fails the validation,
+// even when the field has no values.
+func EqualToEnv(name string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		expected, ok := os.LookupEnv(name)
+		if !ok {
+			return newError("The value is not valid.", message...)
+		}
+
+		for _, val := range values {
+			if subtle.ConstantTimeCompare([]byte(val), []byte(expected)) == 1 {
+				continue
+			}
+
+			return newError("The value is not valid.", message...)
+		}
+
+		return nil
+	}
+}
+
 // Match function validates the form field values with a string.
 func Matches(field string, message ...string) ValidatorFn {
 	return func(values []string) error {
@@ -158,6 +204,166 @@ func GreaterThanOrEqualTo(value float64, message ...string) ValidatorFn {
 	}
 }
 
+// Integer function validates that the values are whole numbers. Values
+// with a decimal point like "5.0" are rejected so the values can always
+// be decoded into integer fields.
+func Integer(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if _, err := strconv.ParseInt(val, 10, 64); err == nil {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not an integer.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// ValidInt function validates that the values are integers in the
+// given base, e.g. 16 for hex identifiers or 2 for binary flags.
+func ValidInt(base int, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if _, err := strconv.ParseInt(val, base, 64); err == nil {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not a valid base %d integer.", val, base), message...)
+		}
+
+		return nil
+	}
+}
+
+// MultipleOf function validates that the values are numbers that
+// are an integer multiple of the step.
+func MultipleOf(step float64, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return newError(fmt.Sprintf("'%s' is not a number.", val), message...)
+			}
+
+			// Small differences are allowed to tolerate floating point errors.
+			q := n / step
+			if math.Abs(q-math.Round(q)) < 1e-9 {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must be a multiple of %v.", val, step), message...)
+		}
+
+		return nil
+	}
+}
+
+// Positive function validates that the values are numbers greater than zero.
+func Positive(message ...string) ValidatorFn {
+	return sign("positive", func(n float64) bool { return n > 0 }, message...)
+}
+
+// Negative function validates that the values are numbers less than zero.
+func Negative(message ...string) ValidatorFn {
+	return sign("negative", func(n float64) bool { return n < 0 }, message...)
+}
+
+// NonNegative function validates that the values are
+// numbers greater than or equal to zero.
+func NonNegative(message ...string) ValidatorFn {
+	return sign("non-negative", func(n float64) bool { return n >= 0 }, message...)
+}
+
+func sign(name string, valid func(float64) bool, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil || math.IsNaN(n) {
+				return newError(fmt.Sprintf("'%s' is not a number.", val), message...)
+			}
+
+			if valid(n) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must be %s.", val, name), message...)
+		}
+
+		return nil
+	}
+}
+
+// Ascending function validates that the values, numbers or dates,
+// are sorted in ascending order. Equal consecutive values are valid.
+func Ascending(message ...string) ValidatorFn {
+	return sorted(false, message...)
+}
+
+// Descending function validates that the values, numbers or dates,
+// are sorted in descending order. Equal consecutive values are valid.
+func Descending(message ...string) ValidatorFn {
+	return sorted(true, message...)
+}
+
+func sorted(descending bool, message ...string) ValidatorFn {
+	order := "ascending"
+	if descending {
+		order = "descending"
+	}
+
+	return func(values []string) error {
+		keys, err := sequence(values)
+		if err != nil {
+			return newError(err.Error(), message...)
+		}
+
+		for i := 1; i < len(keys); i++ {
+			if keys[i-1] == keys[i] || (keys[i-1] < keys[i]) != descending {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' and '%s' are not in %s order.", values[i-1], values[i], order), message...)
+		}
+
+		return nil
+	}
+}
+
+// sequence parses the values as numbers or, when the first one is
+// not a number, as dates. All the values must be of the same kind.
+func sequence(values []string) ([]float64, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	_, err := strconv.ParseFloat(values[0], 64)
+	numbers := err == nil
+
+	keys := make([]float64, 0, len(values))
+	for _, val := range values {
+		if numbers {
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return nil, fmt.Errorf("'%s' is not a number.", val)
+			}
+
+			keys = append(keys, n)
+			continue
+		}
+
+		t, err := parseTime(val)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a number or a date.", val)
+		}
+
+		keys = append(keys, float64(t.Unix()))
+	}
+
+	return keys, nil
+}
+
 // MinLength function validates that the values' lengths are greater than or equal to min.
 func MinLength(min int, message ...string) ValidatorFn {
 	return func(values []string) error {
@@ -188,6 +394,39 @@ func MaxLength(max int, message ...string) ValidatorFn {
 	}
 }
 
+// MaxBytes function validates that the values' sizes in bytes are less
+// than or equal to max, e.g. to fit a column limited to 255 bytes. Unlike
+// MaxLength the values are not trimmed.
+func MaxBytes(max int, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if len(val) <= max {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must not exceed %d bytes.", val, max), message...)
+		}
+
+		return nil
+	}
+}
+
+// MinBytes function validates that the values' sizes
+// in bytes are greater than or equal to min.
+func MinBytes(min int, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if len(val) >= min {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must have at least %d bytes.", val, min), message...)
+		}
+
+		return nil
+	}
+}
+
 // WithinOptions function validates that values are in the option list.
 func WithinOptions(options []string, message ...string) ValidatorFn {
 	return func(values []string) error {
@@ -204,6 +443,344 @@ func WithinOptions(options []string, message ...string) ValidatorFn {
 	}
 }
 
+// OneOfStrings function validates that values are one of the passed ones.
+func OneOfStrings(valid ...string) ValidatorFn {
+	return WithinOptions(valid)
+}
+
+// OneOf function validates that values are in the passed list of a string
+// based type, this allows to reuse the list of values of typed enums that
+// forms are decoded into instead of listing the options again:
+//
+//	type Role string
+//
+//	var Roles = []Role{"admin", "user"}
+//
+//	validate.Field("role", validate.OneOf(Roles))
+func OneOf[T ~string](valid []T, message ...string) ValidatorFn {
+	options := make([]string, len(valid))
+	for i, v := range valid {
+		options[i] = string(v)
+	}
+
+	return WithinOptions(options, message...)
+}
+
+// NotWithinOptions function validates that values are not in the option
+// list, e.g. reserved usernames.
+func NotWithinOptions(options []string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if !slices.Contains(options, val) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not allowed.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// NotWithinOptionsFold function validates that values are not in the
+// option list ignoring case, so "Admin" matches the "admin" option.
+func NotWithinOptionsFold(options []string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			disallowed := slices.ContainsFunc(options, func(option string) bool {
+				return strings.EqualFold(option, val)
+			})
+
+			if !disallowed {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not allowed.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// WithinNumbers function validates that values are numbers in the option list.
+func WithinNumbers(options []float64, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return newError(fmt.Sprintf("'%s' is not a number.", val), message...)
+			}
+
+			if slices.Contains(options, n) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not in the options.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// NoSurroundingWhitespace function validates that the values don't have
+// leading or trailing whitespace such as spaces, tabs or newlines.
+func NoSurroundingWhitespace(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if strings.TrimSpace(val) == val {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must not start or end with whitespace.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// ASCII function validates that the values only contain ASCII characters.
+func ASCII(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if !strings.ContainsFunc(val, func(r rune) bool { return r > unicode.MaxASCII }) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must only contain ASCII characters.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// PrintableASCII function validates that the values only contain printable
+// ASCII characters, control characters such as tabs or newlines are rejected.
+func PrintableASCII(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if !strings.ContainsFunc(val, func(r rune) bool { return r < ' ' || r > '~' }) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must only contain printable ASCII characters.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// AllowedChars function validates that the values only contain
+// characters from the allowed set, e.g. AllowedChars("0123456789ABCDEF").
+func AllowedChars(set string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			i := strings.IndexFunc(val, func(r rune) bool { return !strings.ContainsRune(set, r) })
+			if i < 0 {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' contains the not allowed character '%c'.", val, []rune(val[i:])[0]), message...)
+		}
+
+		return nil
+	}
+}
+
+// CheckDigit function validates the values with the passed checksum
+// function, which allows to plug domain specific check digit algorithms
+// like the ones used in national IDs or reference numbers.
+func CheckDigit(fn func(string) bool, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if fn(val) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' has an invalid check digit.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// EachSatisfies function validates each of the values with the passed
+// callback, e.g. to check that every submitted tag is unique in the
+// database. The error names the first value that failed along with
+// the callback error.
+func EachSatisfies(fn func(string) error, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			err := fn(val)
+			if err == nil {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not valid: %v", val, err), message...)
+		}
+
+		return nil
+	}
+}
+
+// ValidUTF8 function validates that the values are valid UTF-8 strings.
+func ValidUTF8(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if utf8.ValidString(val) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not valid UTF-8.", strings.ToValidUTF8(val, "\uFFFD")), message...)
+		}
+
+		return nil
+	}
+}
+
+// emoji holds the unicode ranges of emoji and pictographs.
+var emoji = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b55, Stride: 1},
+		{Lo: 0xfe0f, Hi: 0xfe0f, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1faff, Stride: 1},
+	},
+}
+
+// NoEmoji function validates that the values don't
+// contain emoji or pictographs.
+func NoEmoji(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if !strings.ContainsFunc(val, func(r rune) bool { return unicode.Is(emoji, r) }) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must not contain emoji.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// SafeRelativePath function validates that the values are relative paths
+// that stay within their base after being cleaned, rejecting absolute paths
+// and paths like "../etc/passwd" that could lead to directory traversal.
+// Backslashes are treated as separators.
+func SafeRelativePath(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			p := strings.ReplaceAll(val, `\`, "/")
+			cleaned := path.Clean(p)
+
+			hasVolume := len(p) > 1 && p[1] == ':'
+			escapes := cleaned == ".." || strings.HasPrefix(cleaned, "../")
+			if p != "" && !path.IsAbs(p) && !hasVolume && !escapes && !strings.ContainsRune(p, 0) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not a safe relative path.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// MaxConsecutiveRepeats function validates that no character
+// in the values repeats more than n times in a row, e.g. "aaaa".
+func MaxConsecutiveRepeats(n int, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			run, last := 0, rune(-1)
+			for _, r := range val {
+				if r != last {
+					run, last = 0, r
+				}
+
+				run++
+				if run > n {
+					return newError(fmt.Sprintf("'%s' must not repeat a character more than %d times in a row.", val, n), message...)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// slugSeparators matches the runs of characters slugs replace with a hyphen.
+var slugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// NormalizedSlug function validates that the values are slugs in their
+// normalized form: lowercase letters and numbers separated by single
+// hyphens. Values like "My Post" or "my--post" are rejected since they
+// normalize to "my-post".
+func NormalizedSlug(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			normalized := strings.Trim(slugSeparators.ReplaceAllString(strings.ToLower(val), "-"), "-")
+			if val != "" && val == normalized {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not a valid slug, expected '%s'.", val, normalized), message...)
+		}
+
+		return nil
+	}
+}
+
+// CSVWithinOptions function validates that each element in the comma
+// separated values is in the option list.
+func CSVWithinOptions(options []string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			for _, item := range csvItems(val) {
+				if slices.Contains(options, item) {
+					continue
+				}
+
+				return newError(fmt.Sprintf("'%s' is not in the options.", item), message...)
+			}
+		}
+
+		return nil
+	}
+}
+
+// CSVMaxItems function validates that the comma separated
+// values don't have more than max elements.
+func CSVMaxItems(max int, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if len(csvItems(val)) <= max {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' must not have more than %d items.", val, max), message...)
+		}
+
+		return nil
+	}
+}
+
+// csvItems splits the value on commas, trimming the
+// spaces around the elements and skipping empty ones.
+func csvItems(val string) []string {
+	var items []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
 // ValidUUID function validates that the values are valid UUIDs.
 func ValidUUID(message ...string) ValidatorFn {
 	return func(values []string) error {
@@ -319,6 +896,184 @@ func TimeAfterOrEqualTo(u time.Time, message ...string) ValidatorFn {
 	}
 }
 
+// Weekday function validates that the values are dates
+// falling on one of the passed days of the week.
+func Weekday(days []time.Weekday, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			t, err := parseTime(val)
+			if err != nil {
+				return newError(fmt.Sprintf("'%s' is not a valid date.", val), message...)
+			}
+
+			if slices.Contains(days, t.Weekday()) {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is a %s.", val, t.Weekday()), message...)
+		}
+
+		return nil
+	}
+}
+
+// NotWeekend function validates that the values are
+// dates from Monday to Friday.
+func NotWeekend(message ...string) ValidatorFn {
+	return Weekday([]time.Weekday{
+		time.Monday,
+		time.Tuesday,
+		time.Wednesday,
+		time.Thursday,
+		time.Friday,
+	}, message...)
+}
+
+// BusinessDay function validates that the values are dates from Monday
+// to Friday that are not one of the passed holidays. Holidays are compared
+// by date, ignoring their time.
+func BusinessDay(holidays []time.Time, message ...string) ValidatorFn {
+	notWeekend := NotWeekend(message...)
+
+	return func(values []string) error {
+		if err := notWeekend(values); err != nil {
+			return err
+		}
+
+		for _, val := range values {
+			t, _ := parseTime(val)
+			isHoliday := slices.ContainsFunc(holidays, func(h time.Time) bool {
+				return h.Format(time.DateOnly) == t.Format(time.DateOnly)
+			})
+
+			if !isHoliday {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is a holiday.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// ValidTime function validates that the values are times in the given layout.
+func ValidTime(layout string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if _, err := time.Parse(layout, val); err == nil {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not a valid time with layout '%s'.", val, layout), message...)
+		}
+
+		return nil
+	}
+}
+
+// ValidDuration function validates that the values are durations
+// parsed by time.ParseDuration, like "30s" or "5m".
+func ValidDuration(message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			if _, err := time.ParseDuration(val); err == nil {
+				continue
+			}
+
+			return newError(fmt.Sprintf("'%s' is not a valid duration.", val), message...)
+		}
+
+		return nil
+	}
+}
+
+// DurationBetween function validates that the values are durations
+// like ValidDuration, within the min and max bounds inclusive.
+func DurationBetween(min, max time.Duration, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return newError(fmt.Sprintf("'%s' is not a valid duration.", val), message...)
+			}
+
+			if d < min || d > max {
+				return newError(fmt.Sprintf("'%s' must be between %s and %s.", val, min, max), message...)
+			}
+		}
+
+		return nil
+	}
+}
+
+// JSONHasKeys function validates that the values are JSON objects
+// containing each of the passed keys.
+func JSONHasKeys(keys []string, message ...string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			var object map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(val), &object); err != nil || object == nil {
+				return newError(fmt.Sprintf("'%s' is not a valid JSON object.", val), message...)
+			}
+
+			for _, key := range keys {
+				if _, ok := object[key]; ok {
+					continue
+				}
+
+				return newError(fmt.Sprintf("'%s' is missing the '%s' key.", val, key), message...)
+			}
+		}
+
+		return nil
+	}
+}
+
+// JSONArrayOf function validates that the values are JSON arrays where
+// each element is an object containing the passed keys. Empty arrays are
+// valid, JSONNonEmptyArrayOf should be used to require at least one element.
+func JSONArrayOf(keys []string, message ...string) ValidatorFn {
+	return jsonArrayOf(true, keys, message)
+}
+
+// JSONNonEmptyArrayOf function validates the values like JSONArrayOf
+// but fails when the array has no elements.
+func JSONNonEmptyArrayOf(keys []string, message ...string) ValidatorFn {
+	return jsonArrayOf(false, keys, message)
+}
+
+func jsonArrayOf(allowEmpty bool, keys, message []string) ValidatorFn {
+	return func(values []string) error {
+		for _, val := range values {
+			var objects []map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(val), &objects); err != nil || objects == nil {
+				return newError(fmt.Sprintf("'%s' is not a valid JSON array of objects.", val), message...)
+			}
+
+			if len(objects) == 0 && !allowEmpty {
+				return newError(fmt.Sprintf("'%s' must not be empty.", val), message...)
+			}
+
+			for i, object := range objects {
+				if object == nil {
+					return newError(fmt.Sprintf("element %d of '%s' is not a JSON object.", i, val), message...)
+				}
+
+				for _, key := range keys {
+					if _, ok := object[key]; ok {
+						continue
+					}
+
+					return newError(fmt.Sprintf("element %d of '%s' is missing the '%s' key.", i, val, key), message...)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func parseTime(strTime string) (time.Time, error) {
 	layouts := []string{
 		time.DateOnly,
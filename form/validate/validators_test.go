@@ -1,10 +1,14 @@
 package validate_test
 
 import (
+	"errors"
 	"net/url"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/leapkit/core/form/validate"
 )
@@ -56,6 +60,38 @@ func TestRuleRequired(test *testing.T) {
 			t.Fatalf("verrs should have errors. verrs=%v", verrs)
 		}
 	})
+
+	// Given a form with a whitespace-only field value, Then the validate.Required rule should return error.
+	test.Run("incorrect form field has only whitespace", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{" \t\n "},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.Required()),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value surrounded by whitespace, Then the validate.Required rule should return no error.
+	test.Run("correct form field has value surrounded by whitespace", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"  value_1  "},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.Required()),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
 }
 
 func TestRuleMatches(test *testing.T) {
@@ -444,6 +480,58 @@ func TestRuleGreaterThanOrEqualTo(test *testing.T) {
 	})
 }
 
+func TestRuleInteger(test *testing.T) {
+	// Given a form with whole number values, Then the Integer rule should return no error.
+	test.Run("correct form field values are integers", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"5", "-10", "0"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.Integer()),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a decimal point value, Then the Integer rule should return error.
+	test.Run("incorrect form field value has a decimal point", func(t *testing.T) {
+		for _, value := range []string{"5.0", "5.5"} {
+			form := url.Values{
+				"input_field": []string{value},
+			}
+
+			validations := validate.Fields(
+				validate.Field("input_field", validate.Integer()),
+			)
+
+			verrs := validations.Validate(form)
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", value, verrs)
+			}
+		}
+	})
+
+	// Given a form with no number values, Then the Integer rule should return error.
+	test.Run("incorrect form field value is not a number", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"invalid value"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.Integer()),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
 func TestRuleMinLength(test *testing.T) {
 	// Given a form field values with a length greater than the compared value, Then the MinLength rule should return no error.
 	test.Run("correct form field values with a length greater than the compared value", func(t *testing.T) {
@@ -578,6 +666,56 @@ func TestRuleWithinOptions(test *testing.T) {
 	})
 }
 
+func TestRuleWithinNumbers(test *testing.T) {
+	// Given a form with values in the options, Then the WithinNumbers rule should return no error.
+	test.Run("correct form field values are in the options", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"1", "2.5", "10.0"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.WithinNumbers([]float64{1, 2.5, 10})),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with values not in the options, Then the WithinNumbers rule should return error.
+	test.Run("incorrect form field value is not in the options", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"3"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.WithinNumbers([]float64{1, 2.5, 10})),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with no number values, Then the WithinNumbers rule should return error.
+	test.Run("incorrect form field value is not a number", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"one"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.WithinNumbers([]float64{1})),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
 func TestRuleValidUUID(test *testing.T) {
 	// Given a form field uuid values, Then the ValidUUID rule should return no error.
 	test.Run("correct form field values are uuids", func(t *testing.T) {
@@ -925,3 +1063,1346 @@ func TestRuleTimeAfterOrEqualTo(test *testing.T) {
 		}
 	})
 }
+
+func TestRuleValidTime(test *testing.T) {
+	// Given a form with values in the layout, Then the ValidTime rule should return no error.
+	test.Run("correct form field values match the layout", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"2024-02-29", "2023-12-31"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.ValidTime(time.DateOnly)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with values in a different layout, Then the ValidTime rule should return error.
+	test.Run("incorrect form field value has a different layout", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"31/12/2023"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.ValidTime(time.DateOnly)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with values that are not times, Then the ValidTime rule should return error.
+	test.Run("incorrect form field value is not a time", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"invalid value"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.ValidTime(time.Kitchen)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleValidDuration(test *testing.T) {
+	// Given a form with duration values, Then the ValidDuration rule should return no error.
+	test.Run("correct form field values are durations", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"30s", "5m", "1h30m", "-2ms"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.ValidDuration()),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with values that are not durations, Then the ValidDuration rule should return error.
+	test.Run("incorrect form field value is not a duration", func(t *testing.T) {
+		for _, val := range []string{"30", "5 minutes", "1d", ""} {
+			form := url.Values{
+				"input_field": []string{val},
+			}
+
+			validations := validate.Fields(
+				validate.Field("input_field", validate.ValidDuration()),
+			)
+
+			verrs := validations.Validate(form)
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %q. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleDurationBetween(test *testing.T) {
+	// Given a form with durations within the bounds, Then the DurationBetween rule should return no error.
+	test.Run("correct form field values are within the bounds", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"1s", "30s", "1m"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.DurationBetween(time.Second, time.Minute)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with durations out of the bounds, Then the DurationBetween rule should return error.
+	test.Run("incorrect form field value is out of the bounds", func(t *testing.T) {
+		for _, val := range []string{"500ms", "61s", "2h"} {
+			form := url.Values{
+				"input_field": []string{val},
+			}
+
+			validations := validate.Fields(
+				validate.Field("input_field", validate.DurationBetween(time.Second, time.Minute)),
+			)
+
+			verrs := validations.Validate(form)
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %q. verrs=%v", val, verrs)
+			}
+
+			if msg := verrs["input_field"][0].Error(); msg != "'"+val+"' must be between 1s and 1m0s." {
+				t.Fatalf("unexpected message %q", msg)
+			}
+		}
+	})
+
+	// Given a form with values that are not durations, Then the DurationBetween rule should return error.
+	test.Run("incorrect form field value is not a duration", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"soon"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.DurationBetween(time.Second, time.Minute)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleRequiredWithout(test *testing.T) {
+	// Given a form with the other field, Then the RequiredWithout rule should return no error.
+	test.Run("correct form has the other field", func(t *testing.T) {
+		form := url.Values{
+			"phone": []string{"555-1234"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("email", validate.RequiredWithout("phone")),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form without the other field but with the field, Then the RequiredWithout rule should return no error.
+	test.Run("correct form has the field", func(t *testing.T) {
+		form := url.Values{
+			"email": []string{"a@pagano.id"},
+			"phone": []string{""},
+		}
+
+		validations := validate.Fields(
+			validate.Field("email", validate.RequiredWithout("phone")),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form without the other field nor the field, Then the RequiredWithout rule should return error.
+	test.Run("incorrect form does not have any of the fields", func(t *testing.T) {
+		forms := []url.Values{
+			{},
+			{"email": []string{""}, "phone": []string{" "}},
+		}
+
+		validations := validate.Fields(
+			validate.Field("email", validate.RequiredWithout("phone")),
+		)
+
+		for _, form := range forms {
+			verrs := validations.Validate(form)
+			if len(verrs["email"]) == 0 {
+				t.Fatalf("verrs should have errors. verrs=%v", verrs)
+			}
+		}
+	})
+}
+
+func TestRuleRequiredWith(test *testing.T) {
+	// Given a form without the other field, Then the RequiredWith rule should return no error.
+	test.Run("correct form does not have the other field", func(t *testing.T) {
+		forms := []url.Values{
+			{},
+			{"address": []string{""}},
+		}
+
+		validations := validate.Fields(
+			validate.Field("city", validate.RequiredWith("address")),
+		)
+
+		for _, form := range forms {
+			verrs := validations.Validate(form)
+			if len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+			}
+		}
+	})
+
+	// Given a form with the other field and the field, Then the RequiredWith rule should return no error.
+	test.Run("correct form has both fields", func(t *testing.T) {
+		form := url.Values{
+			"address": []string{"742 Evergreen Terrace"},
+			"city":    []string{"Springfield"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("city", validate.RequiredWith("address")),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with the other field but without the field, Then the RequiredWith rule should return error.
+	test.Run("incorrect form has the other field only", func(t *testing.T) {
+		form := url.Values{
+			"address": []string{"742 Evergreen Terrace"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("city", validate.RequiredWith("address")),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs["city"]) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRulePostalCode(test *testing.T) {
+	testCases := []struct {
+		country string
+		value   string
+		valid   bool
+	}{
+		{"US", "90210", true},
+		{"US", "90210-1234", true},
+		{"us", "90210", true},
+		{"US", "9021", false},
+		{"US", "90210-12", false},
+		{"CA", "K1A 0B1", true},
+		{"CA", "k1a0b1", true},
+		{"CA", "K1A-0B1", false},
+		{"CA", "12345", false},
+		{"GB", "SW1A 1AA", true},
+		{"ZZ", "AB-123", true},
+		{"ZZ", "not a postal code!", false},
+	}
+
+	for _, tc := range testCases {
+		test.Run(tc.country+" "+tc.value, func(t *testing.T) {
+			form := url.Values{
+				"input_field": []string{tc.value},
+			}
+
+			validations := validate.Fields(
+				validate.Field("input_field", validate.PostalCode(tc.country)),
+			)
+
+			verrs := validations.Validate(form)
+			if tc.valid && len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+			}
+
+			if !tc.valid && len(verrs) == 0 {
+				t.Fatalf("verrs should have errors. verrs=%v", verrs)
+			}
+		})
+	}
+
+	// Given a registered country format, Then the PostalCode rule should use it.
+	test.Run("registered country", func(t *testing.T) {
+		validate.RegisterPostalCode("xx", regexp.MustCompile(`^XX\d{3}$`))
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.PostalCode("XX")),
+		)
+
+		if verrs := validations.Validate(url.Values{"input_field": {"XX123"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"input_field": {"12345"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleJSONHasKeys(test *testing.T) {
+	// Given a form with a JSON object containing all keys, Then the JSONHasKeys rule should return no error.
+	test.Run("correct form field value has all keys", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{`{"name": "leapkit", "version": 1}`},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.JSONHasKeys([]string{"name", "version"})),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a JSON object missing a key, Then the JSONHasKeys rule should return error.
+	test.Run("incorrect form field value is missing a key", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{`{"name": "leapkit"}`},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.JSONHasKeys([]string{"name", "version"})),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with invalid JSON or a non-object, Then the JSONHasKeys rule should return error.
+	test.Run("incorrect form field value is not a JSON object", func(t *testing.T) {
+		for _, val := range []string{`{"name":`, `["name"]`, `null`, `"name"`} {
+			form := url.Values{
+				"input_field": []string{val},
+			}
+
+			validations := validate.Fields(
+				validate.Field("input_field", validate.JSONHasKeys([]string{"name"})),
+			)
+
+			verrs := validations.Validate(form)
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+
+	// Given a custom message, Then the JSONHasKeys rule should use it.
+	test.Run("custom message", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("input_field", validate.JSONHasKeys([]string{"name"}, "Missing the package name")),
+		)
+
+		verrs := validations.Validate(url.Values{"input_field": {`{"version": "1.0"}`}})
+		if len(verrs["input_field"]) == 0 || verrs["input_field"][0].Error() != "Missing the package name" {
+			t.Fatalf("expected the custom message, got %v", verrs)
+		}
+	})
+}
+
+func TestRuleAccepted(test *testing.T) {
+	// Given a form with a truthy checkbox value, Then the Accepted rule should return no error.
+	test.Run("correct form field value is accepted", func(t *testing.T) {
+		for _, val := range []string{"on", "true", "1", "yes"} {
+			form := url.Values{
+				"terms": []string{val},
+			}
+
+			validations := validate.Fields(
+				validate.Field("terms", validate.Accepted()),
+			)
+
+			verrs := validations.Validate(form)
+			if len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors for %s, verrs=%v", val, verrs)
+			}
+		}
+	})
+
+	// Given a form with an unchecked checkbox, Then the Accepted rule should return error.
+	test.Run("incorrect form field value is not accepted", func(t *testing.T) {
+		for _, form := range []url.Values{{}, {"terms": {"off"}}, {"terms": {""}}} {
+			validations := validate.Fields(
+				validate.Field("terms", validate.Accepted()),
+			)
+
+			verrs := validations.Validate(form)
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %v. verrs=%v", form, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleCSV(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("tags", validate.CSVWithinOptions([]string{"go", "web", "sql"}), validate.CSVMaxItems(2)),
+	)
+
+	// Given a form with a valid comma separated list, Then the CSV rules should return no error.
+	test.Run("correct form field value is a valid list", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"tags": {"go , web"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with an element not in the options, Then CSVWithinOptions should return error.
+	test.Run("incorrect form field value has an element out of the options", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"tags": {"go,rust"}})
+		if len(verrs["tags"]) != 1 {
+			t.Fatalf("verrs should have one error. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with more elements than allowed, Then CSVMaxItems should return error.
+	test.Run("incorrect form field value exceeds the item limit", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"tags": {"go,web,sql"}})
+		if len(verrs["tags"]) != 1 {
+			t.Fatalf("verrs should have one error. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleMultipleOf(test *testing.T) {
+	// Given a form with multiples of the step, Then the MultipleOf rule should return no error.
+	test.Run("correct form field values are multiples", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"15", "0", "-10"},
+			"price":       []string{"0.3", "1.2"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.MultipleOf(5)),
+			validate.Field("price", validate.MultipleOf(0.1)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value that is not a multiple, Then the MultipleOf rule should return error.
+	test.Run("incorrect form field value is not a multiple", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"16"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.MultipleOf(5)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value that is not a number, Then the MultipleOf rule should return error.
+	test.Run("incorrect form field value is not a number", func(t *testing.T) {
+		form := url.Values{
+			"input_field": []string{"fifteen"},
+		}
+
+		validations := validate.Fields(
+			validate.Field("input_field", validate.MultipleOf(5)),
+		)
+
+		verrs := validations.Validate(form)
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleNoSurroundingWhitespace(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("username", validate.NoSurroundingWhitespace()),
+	)
+
+	// Given a form with clean values, Then the NoSurroundingWhitespace rule should return no error.
+	test.Run("correct form field value has no surrounding whitespace", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"username": {"john doe"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a leading space, Then the NoSurroundingWhitespace rule should return error.
+	test.Run("incorrect form field value has a leading space", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"username": {" john"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a trailing newline, Then the NoSurroundingWhitespace rule should return error.
+	test.Run("incorrect form field value has a trailing newline", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"username": {"john\n"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleASCII(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("code", validate.ASCII()),
+	)
+
+	// Given a form with plain ASCII values, Then the ASCII rule should return no error.
+	test.Run("correct form field value is ASCII", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"code": {"ABC-123\t~"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with non ASCII values, Then the ASCII rule should return error.
+	test.Run("incorrect form field value is not ASCII", func(t *testing.T) {
+		for _, val := range []string{"canción", "ok 👍"} {
+			verrs := validations.Validate(url.Values{"code": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+
+	// Given a form with control characters, Then the PrintableASCII rule should return error.
+	test.Run("printable ASCII only", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("code", validate.PrintableASCII()),
+		)
+
+		if verrs := validations.Validate(url.Values{"code": {"ABC-123 ~"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		for _, val := range []string{"ABC\t123", "canción", "ok 👍"} {
+			verrs := validations.Validate(url.Values{"code": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %q. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleGreaterThanFieldBy(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("end", validate.GreaterThanFieldBy("start", 60)),
+	)
+
+	// Given a form with a sufficient gap, Then the GreaterThanFieldBy rule should return no error.
+	test.Run("correct form field value exceeds the other by delta", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"start": {"540"}, "end": {"600"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with an insufficient gap, Then the GreaterThanFieldBy rule should return error.
+	test.Run("incorrect form field value has an insufficient gap", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"start": {"540"}, "end": {"570"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with equal values, Then the GreaterThanFieldBy rule should return error.
+	test.Run("incorrect form field value is equal to the other", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"start": {"540"}, "end": {"540"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value that is not a number, Then the GreaterThanFieldBy rule should return error.
+	test.Run("incorrect form field value is not a number", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"start": {"540"}, "end": {"later"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleNotWithinOptions(test *testing.T) {
+	reserved := []string{"admin", "root"}
+
+	// Given a form with an allowed value, Then the NotWithinOptions rule should return no error.
+	test.Run("correct form field value is allowed", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("username", validate.NotWithinOptions(reserved)),
+		)
+
+		verrs := validations.Validate(url.Values{"username": {"antonio", "Admin"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a disallowed value, Then the NotWithinOptions rule should return error.
+	test.Run("incorrect form field value is disallowed", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("username", validate.NotWithinOptions(reserved)),
+		)
+
+		verrs := validations.Validate(url.Values{"username": {"root"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a disallowed value in other case, Then the NotWithinOptionsFold rule should return error.
+	test.Run("incorrect form field value is disallowed ignoring case", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("username", validate.NotWithinOptionsFold(reserved)),
+		)
+
+		if verrs := validations.Validate(url.Values{"username": {"Admin"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"username": {"antonio"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleValidInt(test *testing.T) {
+	// Given a form with a hex value, Then the ValidInt rule with base 16 should return no error.
+	test.Run("correct form field value is hex", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("id", validate.ValidInt(16)),
+		)
+
+		if verrs := validations.Validate(url.Values{"id": {"1aF"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a non binary value, Then the ValidInt rule with base 2 should return error.
+	test.Run("incorrect form field value is not binary", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("flags", validate.ValidInt(2)),
+		)
+
+		if verrs := validations.Validate(url.Values{"flags": {"1012"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"flags": {"1010"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with decimal values, Then the ValidInt rule with base 10 should only accept integers.
+	test.Run("base 10", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("count", validate.ValidInt(10)),
+		)
+
+		if verrs := validations.Validate(url.Values{"count": {"-42"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		for _, val := range []string{"1aF", "4.2"} {
+			if verrs := validations.Validate(url.Values{"count": {val}}); len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+type role string
+
+var roles = []role{"admin", "user"}
+
+func TestRuleOneOf(test *testing.T) {
+	// Given a form with a valid enum value, Then the OneOf rules should return no error.
+	test.Run("correct form field value is in the enum", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("role", validate.OneOf(roles)),
+			validate.Field("status", validate.OneOfStrings("active", "inactive")),
+		)
+
+		verrs := validations.Validate(url.Values{"role": {"admin"}, "status": {"active"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with an invalid enum value, Then the OneOf rules should return error.
+	test.Run("incorrect form field value is not in the enum", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("role", validate.OneOf(roles)),
+			validate.Field("status", validate.OneOfStrings("active", "inactive")),
+		)
+
+		verrs := validations.Validate(url.Values{"role": {"owner"}, "status": {"deleted"}})
+		if len(verrs) != 2 {
+			t.Fatalf("verrs should have 2 errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleNormalizedSlug(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("slug", validate.NormalizedSlug()),
+	)
+
+	// Given a form with a normalized slug, Then the NormalizedSlug rule should return no error.
+	test.Run("correct form field value is a normalized slug", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"slug": {"my-post-2"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with non normalized equivalents, Then the NormalizedSlug rule should return error.
+	test.Run("incorrect form field value is not normalized", func(t *testing.T) {
+		for _, val := range []string{"my--post", "My Post", "-my-post", "my_post", ""} {
+			verrs := validations.Validate(url.Values{"slug": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %q. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleAllowedChars(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("handle", validate.AllowedChars("abcdefghijklmnopqrstuvwxyz0123456789_")),
+	)
+
+	// Given a form with values made of allowed characters, Then the AllowedChars rule should return no error.
+	test.Run("correct form field value has allowed characters", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"handle": {"john_doe42", ""}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value containing a not allowed character, Then the AllowedChars rule should return error.
+	test.Run("incorrect form field value has a not allowed character", func(t *testing.T) {
+		for _, val := range []string{"john-doe", "John", "josé"} {
+			verrs := validations.Validate(url.Values{"handle": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleJSONArrayOf(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("items", validate.JSONArrayOf([]string{"sku", "quantity"})),
+	)
+
+	// Given a form with an array of objects containing all keys, Then the JSONArrayOf rule should return no error.
+	test.Run("correct form field value is an array of objects", func(t *testing.T) {
+		for _, val := range []string{`[{"sku": "A1", "quantity": 2}, {"sku": "B2", "quantity": 1}]`, `[]`} {
+			verrs := validations.Validate(url.Values{"items": {val}})
+			if len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors for %s, verrs=%v", val, verrs)
+			}
+		}
+	})
+
+	// Given a form with an element missing a key, Then the JSONArrayOf rule should return error.
+	test.Run("incorrect form field value has an element missing a key", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"items": {`[{"sku": "A1", "quantity": 2}, {"sku": "B2"}]`}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value that is not an array of objects, Then the JSONArrayOf rule should return error.
+	test.Run("incorrect form field value is not an array of objects", func(t *testing.T) {
+		for _, val := range []string{`{"sku": "A1", "quantity": 2}`, `["A1"]`, `[null]`, `null`, `[{"sku":`} {
+			verrs := validations.Validate(url.Values{"items": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+
+	// Given a form with an empty array, Then the JSONNonEmptyArrayOf rule should return error.
+	test.Run("non empty array", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("items", validate.JSONNonEmptyArrayOf([]string{"sku"})),
+		)
+
+		if verrs := validations.Validate(url.Values{"items": {`[{"sku": "A1"}]`}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"items": {`[]`}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a custom message, Then the JSONArrayOf and JSONNonEmptyArrayOf rules should use it.
+	test.Run("custom message", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("items", validate.JSONArrayOf([]string{"sku"}, "Invalid items")),
+			validate.Field("lines", validate.JSONNonEmptyArrayOf([]string{"sku"}, "Add at least one line")),
+		)
+
+		verrs := validations.Validate(url.Values{"items": {`[{"qty": 1}]`}, "lines": {`[]`}})
+		if len(verrs["items"]) == 0 || verrs["items"][0].Error() != "Invalid items" {
+			t.Fatalf("expected the custom message for items, got %v", verrs)
+		}
+
+		if len(verrs["lines"]) == 0 || verrs["lines"][0].Error() != "Add at least one line" {
+			t.Fatalf("expected the custom message for lines, got %v", verrs)
+		}
+	})
+}
+
+func TestRuleBusinessDay(test *testing.T) {
+	holidays := []time.Time{
+		time.Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC),
+	}
+
+	validations := validate.Fields(
+		validate.Field("date", validate.BusinessDay(holidays)),
+	)
+
+	// Given a form with a Monday, Then the BusinessDay rule should return no error.
+	test.Run("correct form field value is a Monday", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"date": {"2024-12-23"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a Saturday, Then the BusinessDay rule should return error.
+	test.Run("incorrect form field value is a Saturday", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"date": {"2024-12-21"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a configured holiday, Then the BusinessDay rule should return error.
+	test.Run("incorrect form field value is a holiday", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"date": {"2024-12-25"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with an invalid date, Then the BusinessDay rule should return error.
+	test.Run("incorrect form field value is not a date", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"date": {"someday"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given the days of the week, Then the Weekday and NotWeekend rules should only accept those days.
+	test.Run("weekday and not weekend", func(t *testing.T) {
+		weekend := validate.Fields(
+			validate.Field("date", validate.Weekday([]time.Weekday{time.Saturday, time.Sunday})),
+		)
+
+		if verrs := weekend.Validate(url.Values{"date": {"2024-12-21"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		notWeekend := validate.Fields(
+			validate.Field("date", validate.NotWeekend()),
+		)
+
+		if verrs := notWeekend.Validate(url.Values{"date": {"2024-12-22"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleAscending(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("steps", validate.Ascending()),
+	)
+
+	// Given a form with sorted values, Then the Ascending rule should return no error.
+	test.Run("correct form field values are sorted", func(t *testing.T) {
+		for _, vals := range [][]string{{"1", "2.5", "2.5", "10"}, {"2024-01-01", "2024-02-01"}, {"7"}} {
+			verrs := validations.Validate(url.Values{"steps": vals})
+			if len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors for %v, verrs=%v", vals, verrs)
+			}
+		}
+	})
+
+	// Given a form with unsorted values, Then the Ascending rule should return error.
+	test.Run("incorrect form field values are not sorted", func(t *testing.T) {
+		for _, vals := range [][]string{{"1", "10", "2"}, {"2024-02-01", "2024-01-01"}} {
+			verrs := validations.Validate(url.Values{"steps": vals})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %v. verrs=%v", vals, verrs)
+			}
+		}
+	})
+
+	// Given a form with a non numeric entry, Then the Ascending rule should return error.
+	test.Run("incorrect form field values have a non numeric entry", func(t *testing.T) {
+		for _, vals := range [][]string{{"1", "two", "3"}, {"2024-01-01", "3"}} {
+			verrs := validations.Validate(url.Values{"steps": vals})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %v. verrs=%v", vals, verrs)
+			}
+		}
+	})
+
+	// Given a form with values sorted in descending order, Then the Descending rule should return no error.
+	test.Run("descending", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("steps", validate.Descending()),
+		)
+
+		if verrs := validations.Validate(url.Values{"steps": {"10", "2", "2", "1"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"steps": {"1", "2"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleCheckDigit(test *testing.T) {
+	// mod10 checks that the last digit is the sum of the others modulo 10.
+	mod10 := func(val string) bool {
+		if len(val) < 2 {
+			return false
+		}
+
+		sum := 0
+		for _, r := range val[:len(val)-1] {
+			if r < '0' || r > '9' {
+				return false
+			}
+
+			sum += int(r - '0')
+		}
+
+		return int(val[len(val)-1]-'0') == sum%10
+	}
+
+	validations := validate.Fields(
+		validate.Field("reference", validate.CheckDigit(mod10)),
+	)
+
+	// Given a form with a value with a valid check digit, Then the CheckDigit rule should return no error.
+	test.Run("correct form field value has a valid check digit", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"reference": {"12340", "55"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value with an invalid check digit, Then the CheckDigit rule should return error.
+	test.Run("incorrect form field value has an invalid check digit", func(t *testing.T) {
+		for _, val := range []string{"12341", "1a3", ""} {
+			verrs := validations.Validate(url.Values{"reference": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleEachSatisfies(test *testing.T) {
+	// taken simulates the tags already stored in the database.
+	taken := map[string]bool{"go": true}
+	unique := func(val string) error {
+		if taken[val] {
+			return errors.New("already exists")
+		}
+
+		return nil
+	}
+
+	validations := validate.Fields(
+		validate.Field("tags", validate.EachSatisfies(unique)),
+	)
+
+	// Given a form with values satisfying the callback, Then the EachSatisfies rule should return no error.
+	test.Run("correct form field values satisfy the callback", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"tags": {"rust", "zig"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a value failing the callback, Then the EachSatisfies rule should return an error naming it.
+	test.Run("incorrect form field value fails the callback", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"tags": {"rust", "go", "zig"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+
+		if msg := verrs["tags"][0].Error(); msg != "'go' is not valid: already exists" {
+			t.Fatalf("expected the error to name the failing value, got %q", msg)
+		}
+	})
+}
+
+func TestRuleNoEmoji(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("message", validate.NoEmoji()),
+	)
+
+	// Given a form with plain text, Then the NoEmoji rule should return no error.
+	test.Run("correct form field value is plain text", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"message": {"Hola, ¿cómo estás? © 2024 — ok!"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with emoji, Then the NoEmoji rule should return error.
+	test.Run("incorrect form field value contains emoji", func(t *testing.T) {
+		for _, val := range []string{"ok 👍", "🇦🇷", "on fire 🔥", "sunny ☀️", "wait ⏳"} {
+			verrs := validations.Validate(url.Values{"message": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleSafeRelativePath(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("path", validate.SafeRelativePath()),
+	)
+
+	// Given a form with clean relative paths, Then the SafeRelativePath rule should return no error.
+	test.Run("correct form field value is a relative path", func(t *testing.T) {
+		for _, val := range []string{"docs/report.pdf", "a/../b.txt", "./notes.md"} {
+			verrs := validations.Validate(url.Values{"path": {val}})
+			if len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors for %s, verrs=%v", val, verrs)
+			}
+		}
+	})
+
+	// Given a form with absolute or escaping paths, Then the SafeRelativePath rule should return error.
+	test.Run("incorrect form field value escapes the base", func(t *testing.T) {
+		for _, val := range []string{"../etc/passwd", "docs/../../secret", "/etc/passwd", `..\windows`, `C:\Windows`, ".."} {
+			verrs := validations.Validate(url.Values{"path": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleMaxConsecutiveRepeats(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("password", validate.MaxConsecutiveRepeats(3)),
+	)
+
+	// Given a form with values within the limit, Then the MaxConsecutiveRepeats rule should return no error.
+	test.Run("correct form field value is within the limit", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"password": {"aaabbbccc", "añññb", ""}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a long run of a character, Then the MaxConsecutiveRepeats rule should return error.
+	test.Run("incorrect form field value has a long run", func(t *testing.T) {
+		for _, val := range []string{"aaaa", "secret1111", "ññññ"} {
+			verrs := validations.Validate(url.Values{"password": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleValidUTF8(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("comment", validate.ValidUTF8()),
+	)
+
+	// Given a form with valid UTF-8 values, Then the ValidUTF8 rule should return no error.
+	test.Run("correct form field value is valid UTF-8", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"comment": {"canción 👍", ""}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with invalid bytes, Then the ValidUTF8 rule should return error.
+	test.Run("incorrect form field value has invalid bytes", func(t *testing.T) {
+		for _, val := range []string{"bad \xff byte", "\xc3\x28", "truncated \xe2\x82"} {
+			verrs := validations.Validate(url.Values{"comment": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %q. verrs=%v", val, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleSameAs(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("password_confirmation", validate.SameAs("password")),
+	)
+
+	// Given a form with a matching confirmation, Then the SameAs rule should return no error.
+	test.Run("correct form field matches the other field", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"password":              {"s3cr3t!"},
+			"password_confirmation": {"s3cr3t!"},
+		})
+
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a different confirmation, Then the SameAs rule should return an error naming both fields.
+	test.Run("incorrect form field does not match the other field", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"password":              {"s3cr3t!"},
+			"password_confirmation": {"secret"},
+		})
+
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+
+		msg := verrs["password_confirmation"][0].Error()
+		if !strings.Contains(msg, "password_confirmation") || !strings.Contains(msg, "'password'") {
+			t.Fatalf("the error should name both fields, got %q", msg)
+		}
+	})
+
+	// Given a form without the other field, Then the SameAs rule should return error.
+	test.Run("incorrect form does not have the other field", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"password_confirmation": {"s3cr3t!"},
+		})
+
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleRequiredIf(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("state", validate.RequiredIf("country", "US")),
+	)
+
+	// Given a form where the condition is met and the field is present, Then the RequiredIf rule should return no error.
+	test.Run("correct form has the field when triggered", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{
+			"country": {"US"},
+			"state":   {"CA"},
+		})
+
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form where the condition is met and the field is empty, Then the RequiredIf rule should return an error naming both fields.
+	test.Run("incorrect form does not have the field when triggered", func(t *testing.T) {
+		for _, form := range []url.Values{{"country": {"US"}}, {"country": {"US"}, "state": {" "}}} {
+			verrs := validations.Validate(form)
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors. verrs=%v", verrs)
+			}
+
+			msg := verrs["state"][0].Error()
+			if !strings.Contains(msg, "'state'") || !strings.Contains(msg, "'country'") {
+				t.Fatalf("the error should name both fields, got %q", msg)
+			}
+		}
+	})
+
+	// Given a form where the condition is not met, Then the RequiredIf rule should return no error.
+	test.Run("correct form is not triggered", func(t *testing.T) {
+		for _, form := range []url.Values{{"country": {"AR"}}, {"country": {""}}, {}} {
+			verrs := validations.Validate(form)
+			if len(verrs) > 0 {
+				t.Fatalf("verrs must not have errors for %v, verrs=%v", form, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleMaxBytes(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("title", validate.MaxBytes(8)),
+	)
+
+	// Given a form with values within the byte limit, Then the MaxBytes rule should return no error.
+	test.Run("correct form field value is within the byte limit", func(t *testing.T) {
+		verrs := validations.Validate(url.Values{"title": {"12345678", "ñandú"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with a multi-byte value under the rune limit, Then the MaxBytes rule should return error.
+	test.Run("incorrect form field value exceeds the byte limit", func(t *testing.T) {
+		val := "canción👍"
+		if utf8.RuneCountInString(val) > 8 {
+			t.Fatalf("%s should have at most 8 runes", val)
+		}
+
+		verrs := validations.Validate(url.Values{"title": {val}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given a form with values under the byte minimum, Then the MinBytes rule should return error.
+	test.Run("min bytes", func(t *testing.T) {
+		validations := validate.Fields(
+			validate.Field("title", validate.MinBytes(4)),
+		)
+
+		if verrs := validations.Validate(url.Values{"title": {"ññ"}}); len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+
+		if verrs := validations.Validate(url.Values{"title": {"abc"}}); len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+}
+
+func TestRuleEqualToEnv(test *testing.T) {
+	validations := validate.Fields(
+		validate.Field("token", validate.EqualToEnv("LEAPKIT_TEST_TOKEN")),
+	)
+
+	// Given the env variable set and a matching value, Then the EqualToEnv rule should return no error.
+	test.Run("correct form field value matches the env variable", func(t *testing.T) {
+		t.Setenv("LEAPKIT_TEST_TOKEN", "s3cr3t")
+
+		verrs := validations.Validate(url.Values{"token": {"s3cr3t"}})
+		if len(verrs) > 0 {
+			t.Fatalf("verrs must not have errors, verrs=%v", verrs)
+		}
+	})
+
+	// Given the env variable set and a different value, Then the EqualToEnv rule should return error.
+	test.Run("incorrect form field value does not match the env variable", func(t *testing.T) {
+		t.Setenv("LEAPKIT_TEST_TOKEN", "s3cr3t")
+
+		verrs := validations.Validate(url.Values{"token": {"secret"}})
+		if len(verrs) == 0 {
+			t.Fatalf("verrs should have errors. verrs=%v", verrs)
+		}
+	})
+
+	// Given the env variable unset, Then the EqualToEnv rule should return error.
+	test.Run("incorrect env variable is not set", func(t *testing.T) {
+		t.Setenv("LEAPKIT_TEST_TOKEN", "")
+		os.Unsetenv("LEAPKIT_TEST_TOKEN")
+
+		for _, val := range []string{"", "s3cr3t"} {
+			verrs := validations.Validate(url.Values{"token": {val}})
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %q. verrs=%v", val, verrs)
+			}
+		}
+	})
+
+	// Given the env variable unset and the field absent or empty, Then the EqualToEnv rule should return error.
+	test.Run("incorrect env variable is not set and field has no values", func(t *testing.T) {
+		t.Setenv("LEAPKIT_TEST_TOKEN", "")
+		os.Unsetenv("LEAPKIT_TEST_TOKEN")
+
+		for _, form := range []url.Values{{}, {"token": {}}} {
+			verrs := validations.Validate(form)
+			if len(verrs) == 0 {
+				t.Fatalf("verrs should have errors for %v. verrs=%v", form, verrs)
+			}
+		}
+	})
+}
+
+func TestRuleSign(test *testing.T) {
+	tcases := []struct {
+		name    string
+		rule    validate.ValidatorFn
+		valid   []string
+		invalid []string
+	}{
+		{"Positive", validate.Positive(), []string{"1", "0.01", "1e3"}, []string{"0", "-0", "-1", "abc", "NaN"}},
+		{"Negative", validate.Negative(), []string{"-1", "-0.01"}, []string{"0", "-0", "1", "abc"}},
+		{"NonNegative", validate.NonNegative(), []string{"0", "-0", "0.5", "10"}, []string{"-0.01", "-1", "abc", ""}},
+	}
+
+	for _, tcase := range tcases {
+		validations := validate.Fields(
+			validate.Field("amount", tcase.rule),
+		)
+
+		// Given a form with values of the right sign, Then the rule should return no error.
+		test.Run(tcase.name+" correct form field values", func(t *testing.T) {
+			for _, val := range tcase.valid {
+				verrs := validations.Validate(url.Values{"amount": {val}})
+				if len(verrs) > 0 {
+					t.Fatalf("verrs must not have errors for %s, verrs=%v", val, verrs)
+				}
+			}
+		})
+
+		// Given a form with values of the wrong sign or not numbers, Then the rule should return error.
+		test.Run(tcase.name+" incorrect form field values", func(t *testing.T) {
+			for _, val := range tcase.invalid {
+				verrs := validations.Validate(url.Values{"amount": {val}})
+				if len(verrs) == 0 {
+					t.Fatalf("verrs should have errors for %s. verrs=%v", val, verrs)
+				}
+			}
+		})
+	}
+}
@@ -97,3 +97,99 @@ func TestValidate(t *testing.T) {
 		}
 	})
 }
+
+func TestDecodeAndValidate(t *testing.T) {
+	reqFromParams := func(params url.Values) *http.Request {
+		req := httptest.NewRequest("POST", "/", bytes.NewBufferString(params.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		return req
+	}
+
+	rules := validate.Fields(
+		validate.Field("name", validate.Required()),
+		validate.Field("age", validate.GreaterThanOrEqualTo(18)),
+	)
+
+	type person struct {
+		Name string `form:"name"`
+		Age  int    `form:"age"`
+	}
+
+	t.Run("clean submission", func(t *testing.T) {
+		req := reqFromParams(url.Values{
+			"name": {"John"},
+			"age":  {"30"},
+		})
+
+		var p person
+		verrs, err := form.DecodeAndValidate(req, &p, rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(verrs) > 0 {
+			t.Fatalf("expected no errors, got %v", verrs)
+		}
+
+		if p.Name != "John" || p.Age != 30 {
+			t.Fatalf("expected John 30, got %v", p)
+		}
+	})
+
+	t.Run("failed validation", func(t *testing.T) {
+		req := reqFromParams(url.Values{
+			"name": {""},
+			"age":  {"17"},
+		})
+
+		var p person
+		verrs, err := form.DecodeAndValidate(req, &p, rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(verrs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", verrs)
+		}
+
+		if p.Age != 17 {
+			t.Fatalf("expected age to be decoded, got %v", p.Age)
+		}
+	})
+
+	t.Run("query string submission", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/people?name=John&age=17", nil)
+
+		var p person
+		verrs, err := form.DecodeAndValidate(req, &p, rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Name != "John" || p.Age != 17 {
+			t.Fatalf("expected John 17, got %v", p)
+		}
+
+		if len(verrs) != 1 || verrs["age"] == nil {
+			t.Fatalf("expected age error, got %v", verrs)
+		}
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		req := reqFromParams(url.Values{
+			"name": {"John"},
+			"age":  {"old"},
+		})
+
+		var p person
+		verrs, err := form.DecodeAndValidate(req, &p, rules)
+		if err == nil {
+			t.Fatal("expected decode error")
+		}
+
+		if verrs != nil {
+			t.Fatalf("expected no validation errors, got %v", verrs)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package assets
+
+import "github.com/leapkit/core/render/hctx"
+
+// Keys to be used in templates for the functions in this package.
+const (
+	ScriptTagKey   = "scriptTag"
+	PictureKey     = "picture"
+	SrcsetKey      = "srcset"
+	CriticalCSSKey = "criticalCSS"
+)
+
+// New returns a map of the helpers within this package.
+func New() hctx.Map {
+	return hctx.Map{
+		ScriptTagKey:   ScriptTag,
+		PictureKey:     Picture,
+		SrcsetKey:      Srcset,
+		CriticalCSSKey: CriticalCSS,
+	}
+}
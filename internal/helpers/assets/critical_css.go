@@ -0,0 +1,68 @@
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// byter is implemented by the assets manager to return
+// the contents of a file as it's served.
+type byter interface {
+	Bytes(name string) ([]byte, error)
+}
+
+// CriticalCSS inlines the critical stylesheet in a <style> block and adds
+// a <link> for the main stylesheet that loads without blocking rendering,
+// using the media="print" swap with a <noscript> fallback. When the context
+// has a "cspNonce" value it's added to the <style> block and to the <script>
+// doing the swap.
+//
+//	<%= criticalCSS("critical.css", "app.css") %>
+func CriticalCSS(critical, stylesheet string, help hctx.HelperContext) (template.HTML, error) {
+	manager, ok := help.Value("assets").(interface {
+		pather
+		byter
+	})
+
+	if !ok {
+		return "", errors.New("criticalCSS: could not find the assets manager in the context")
+	}
+
+	css, err := manager.Bytes(critical)
+	if err != nil {
+		return "", fmt.Errorf("criticalCSS: %w", err)
+	}
+
+	// The inlined CSS must not be able to close the style block.
+	if strings.Contains(strings.ToLower(string(css)), "</style") {
+		return "", fmt.Errorf("criticalCSS: %s contains a closing style tag", critical)
+	}
+
+	href, err := manager.PathFor(stylesheet)
+	if err != nil {
+		return "", fmt.Errorf("criticalCSS: %w", err)
+	}
+
+	nonce := ""
+	if n, ok := help.Value("cspNonce").(string); ok && n != "" {
+		nonce = fmt.Sprintf(` nonce="%s"`, template.HTMLEscapeString(n))
+	}
+
+	href = template.HTMLEscapeString(href)
+	return template.HTML(fmt.Sprintf(
+		`<style%s>%s</style>`+
+			`<link rel="stylesheet" href="%s" media="print">`+
+			`<script%s>%s</script>`+
+			`<noscript><link rel="stylesheet" href="%s"></noscript>`,
+		nonce, css, href, nonce, mediaSwap, href,
+	)), nil
+}
+
+// mediaSwap switches the preceding stylesheet link to all media once it
+// loads. It's a script instead of an onload attribute so it can carry the
+// nonce, inline event handlers are blocked by the content security policy.
+const mediaSwap = `(function(l){if(l.sheet){l.media='all'}else{l.addEventListener('load',function(){l.media='all'})}})(document.currentScript.previousElementSibling)`
@@ -0,0 +1,50 @@
+package assets
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CriticalCSS(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("assets", assets.NewManager(fstest.MapFS{
+		"critical.css": {Data: []byte("body{margin:0}")},
+		"app.css":      {Data: []byte("AAA")},
+		"broken.css":   {Data: []byte("body{}</STYLE><script>")},
+	}))
+
+	s, err := CriticalCSS("critical.css", "app.css", hc)
+	r.NoError(err)
+	r.Equal(
+		`<style>body{margin:0}</style>`+
+			`<link rel="stylesheet" href="/public/app-e1faffb3e614e6c2fba74296962386b7.css" media="print">`+
+			`<script>`+mediaSwap+`</script>`+
+			`<noscript><link rel="stylesheet" href="/public/app-e1faffb3e614e6c2fba74296962386b7.css"></noscript>`,
+		string(s),
+	)
+
+	hc.Set("cspNonce", "r4nd0m")
+	s, err = CriticalCSS("critical.css", "app.css", hc)
+	r.NoError(err)
+	r.Contains(string(s), `<style nonce="r4nd0m">body{margin:0}</style>`)
+	r.Contains(string(s), `<script nonce="r4nd0m">`+mediaSwap+`</script>`)
+	r.NotContains(string(s), `onload="`)
+
+	_, err = CriticalCSS("missing.css", "app.css", hc)
+	r.Error(err)
+
+	_, err = CriticalCSS("critical.css", "missing.css", hc)
+	r.Error(err)
+
+	_, err = CriticalCSS("broken.css", "app.css", hc)
+	r.Error(err)
+
+	_, err = CriticalCSS("critical.css", "app.css", helptest.NewContext())
+	r.Error(err)
+}
@@ -0,0 +1,153 @@
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"mime"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// webpPather is implemented by the assets manager to return
+// the fingerprinted path of the .webp variant of an image.
+type webpPather interface {
+	WebPPathFor(name string) (string, error)
+}
+
+// Picture renders a <picture> element for the passed image with a fallback
+// <img> using the fingerprinted path of the original. When the manager has
+// the .webp variants of the image, generated with assets.WithWebP, a WebP
+// <source> is added first.
+//
+// The widths option lists the sizes the image is available in, these are
+// expected next to the original as "<name>-<width>w.<ext>" files, e.g.
+// "hero-640w.jpg", and are added to the srcset of the sources. Any other
+// option is rendered as an attribute of the <img>.
+//
+//	<%= picture("hero.jpg", {widths: [640, 1280], alt: "Hero"}) %>
+func Picture(name string, opts hctx.Map, help hctx.HelperContext) (template.HTML, error) {
+	manager, ok := help.Value("assets").(pather)
+	if !ok {
+		return "", errors.New("picture: could not find the assets manager in the context")
+	}
+
+	attrs := map[string]interface{}{}
+	for k, v := range opts {
+		attrs[k] = v
+	}
+
+	widths, err := pictureWidths(attrs["widths"])
+	if err != nil {
+		return "", fmt.Errorf("picture: %w", err)
+	}
+
+	delete(attrs, "widths")
+
+	src, err := manager.PathFor(name)
+	if err != nil {
+		return "", fmt.Errorf("picture: %w", err)
+	}
+
+	srcset, err := pictureSrcset(manager.PathFor, name, widths)
+	if err != nil {
+		return "", fmt.Errorf("picture: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<picture>")
+
+	// The WebP source is only added when all of its variants exist.
+	if wp, ok := manager.(webpPather); ok {
+		if webp, err := pictureSrcset(wp.WebPPathFor, name, widths); err == nil {
+			sb.WriteString(pictureSource("image/webp", webp, attrs["sizes"]))
+		}
+	}
+
+	if len(widths) > 0 {
+		sb.WriteString(pictureSource(mime.TypeByExtension(path.Ext(name)), srcset, attrs["sizes"]))
+	}
+
+	delete(attrs, "sizes")
+	attrs["src"] = src
+
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	sb.WriteString("<img")
+	for _, k := range keys {
+		sb.WriteString(fmt.Sprintf(` %s="%s"`, template.HTMLEscapeString(k), template.HTMLEscapeString(fmt.Sprint(attrs[k]))))
+	}
+
+	sb.WriteString("></picture>")
+
+	return template.HTML(sb.String()), nil
+}
+
+// pictureSrcset builds the srcset for the image using the passed function
+// to resolve the paths. Without widths it's the path of the image itself.
+func pictureSrcset(pathFor func(string) (string, error), name string, widths []int) (string, error) {
+	if len(widths) == 0 {
+		return pathFor(name)
+	}
+
+	ext := path.Ext(name)
+	candidates := make([]string, 0, len(widths))
+	for _, w := range widths {
+		p, err := pathFor(fmt.Sprintf("%s-%dw%s", strings.TrimSuffix(name, ext), w, ext))
+		if err != nil {
+			return "", err
+		}
+
+		candidates = append(candidates, fmt.Sprintf("%s %dw", p, w))
+	}
+
+	return strings.Join(candidates, ", "), nil
+}
+
+// pictureSource renders a <source> element, the sizes attribute
+// is only added when it was passed in the options.
+func pictureSource(mimeType, srcset string, sizes interface{}) string {
+	tag := fmt.Sprintf(`<source type="%s" srcset="%s"`, template.HTMLEscapeString(mimeType), template.HTMLEscapeString(srcset))
+	if sizes != nil {
+		tag += fmt.Sprintf(` sizes="%s"`, template.HTMLEscapeString(fmt.Sprint(sizes)))
+	}
+
+	return tag + ">"
+}
+
+// pictureWidths converts the widths option, which comes from
+// templates as a list of numbers, into a slice of ints.
+func pictureWidths(v interface{}) ([]int, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []int:
+		return v, nil
+	case []interface{}:
+		widths := make([]int, 0, len(v))
+		for _, w := range v {
+			switch w := w.(type) {
+			case int:
+				widths = append(widths, w)
+			case int64:
+				widths = append(widths, int(w))
+			case float64:
+				widths = append(widths, int(w))
+			default:
+				return nil, fmt.Errorf("invalid width %v", w)
+			}
+		}
+
+		return widths, nil
+	}
+
+	return nil, fmt.Errorf("invalid widths %v", v)
+}
@@ -0,0 +1,77 @@
+package assets
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/leapkit/core/render/hctx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Picture(t *testing.T) {
+	hc := helptest.NewContext()
+	hc.Set("assets", assets.NewManager(fstest.MapFS{
+		"hero.jpg":       {Data: []byte("AAA")},
+		"hero.webp":      {Data: []byte("BBB")},
+		"hero-640w.jpg":  {Data: []byte("CCC")},
+		"hero-640w.webp": {Data: []byte("DDD")},
+		"logo.png":       {Data: []byte("AAA")},
+	}))
+
+	tcases := []struct {
+		name     string
+		image    string
+		opts     hctx.Map
+		expected string
+	}{
+		{
+			name:     "webp variant",
+			image:    "hero.jpg",
+			opts:     hctx.Map{"alt": "Hero"},
+			expected: `<picture><source type="image/webp" srcset="/public/hero-2bb225f0ba9a58930757a868ed57d9a3.webp"><img alt="Hero" src="/public/hero-e1faffb3e614e6c2fba74296962386b7.jpg"></picture>`,
+		},
+		{
+			name:     "widths",
+			image:    "hero.jpg",
+			opts:     hctx.Map{"widths": []interface{}{640}, "sizes": "100vw"},
+			expected: `<picture><source type="image/webp" srcset="/public/hero-640w-45054f47ac3305a2a33e9bcceadff712.webp 640w" sizes="100vw"><source type="image/jpeg" srcset="/public/hero-640w-defb99e69a9f1f6e06f15006b1f166ae.jpg 640w" sizes="100vw"><img src="/public/hero-e1faffb3e614e6c2fba74296962386b7.jpg"></picture>`,
+		},
+		{
+			name:     "without webp variant",
+			image:    "logo.png",
+			opts:     hctx.Map{},
+			expected: `<picture><img src="/public/logo-e1faffb3e614e6c2fba74296962386b7.png"></picture>`,
+		},
+	}
+
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			s, err := Picture(tcase.image, tcase.opts, hc)
+			require.NoError(t, err)
+			require.Equal(t, tcase.expected, string(s))
+		})
+	}
+}
+
+func Test_Picture_Errors(t *testing.T) {
+	r := require.New(t)
+
+	_, err := Picture("hero.jpg", hctx.Map{}, helptest.NewContext())
+	r.Error(err)
+
+	hc := helptest.NewContext()
+	hc.Set("assets", assets.NewManager(fstest.MapFS{
+		"hero.jpg": {Data: []byte("AAA")},
+	}))
+
+	_, err = Picture("missing.jpg", hctx.Map{}, hc)
+	r.Error(err)
+
+	_, err = Picture("hero.jpg", hctx.Map{"widths": []interface{}{640}}, hc)
+	r.Error(err)
+
+	_, err = Picture("hero.jpg", hctx.Map{"widths": "640"}, hc)
+	r.Error(err)
+}
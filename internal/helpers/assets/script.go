@@ -0,0 +1,53 @@
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// pather is implemented by the assets manager to
+// return the fingerprinted path of a file.
+type pather interface {
+	PathFor(name string) (string, error)
+}
+
+// integrator is implemented by the assets manager to
+// return the integrity hash of a file.
+type integrator interface {
+	IntegrityFor(name string) (string, error)
+}
+
+// ScriptTag renders a script tag for the passed asset using the fingerprinted
+// path and its integrity hash. The assets manager is taken from the "assets"
+// value in the context, when it can't provide the integrity hash the tag is
+// rendered without it. When the context has a "cspNonce" value, as set by
+// render.CSPMiddleware, it's added as the nonce attribute.
+//
+//	<%= scriptTag("app.js") %>
+func ScriptTag(name string, help hctx.HelperContext) (template.HTML, error) {
+	manager, ok := help.Value("assets").(pather)
+	if !ok {
+		return "", errors.New("scriptTag: could not find the assets manager in the context")
+	}
+
+	src, err := manager.PathFor(name)
+	if err != nil {
+		return "", fmt.Errorf("scriptTag: %w", err)
+	}
+
+	tag := fmt.Sprintf(`<script src="%s"`, template.HTMLEscapeString(src))
+	if ig, ok := manager.(integrator); ok {
+		if integrity, err := ig.IntegrityFor(name); err == nil && integrity != "" {
+			tag += fmt.Sprintf(` integrity="%s" crossorigin="anonymous"`, template.HTMLEscapeString(integrity))
+		}
+	}
+
+	if nonce, ok := help.Value("cspNonce").(string); ok && nonce != "" {
+		tag += fmt.Sprintf(` nonce="%s"`, template.HTMLEscapeString(nonce))
+	}
+
+	return template.HTML(tag + "></script>"), nil
+}
@@ -0,0 +1,66 @@
+package assets
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/stretchr/testify/require"
+)
+
+// pathOnly provides fingerprinted paths without integrity hashes.
+type pathOnly struct{}
+
+func (pathOnly) PathFor(name string) (string, error) {
+	return "/public/app-abc123.js", nil
+}
+
+func Test_ScriptTag(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("assets", assets.NewManager(fstest.MapFS{
+		"app.js": {Data: []byte("AAA")},
+	}))
+
+	s, err := ScriptTag("app.js", hc)
+	r.NoError(err)
+	r.Equal(`<script src="/public/app-e1faffb3e614e6c2fba74296962386b7.js" integrity="sha384-ilt8GbzRcE1SH4a5YY2G3g7Uj6KXEa1NFiMPfSazYRG+r3/v6LO+ehfODhQMoAL+" crossorigin="anonymous"></script>`, string(s))
+}
+
+func Test_ScriptTag_NoIntegrity(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("assets", pathOnly{})
+
+	s, err := ScriptTag("app.js", hc)
+	r.NoError(err)
+	r.Equal(`<script src="/public/app-abc123.js"></script>`, string(s))
+}
+
+func Test_ScriptTag_Errors(t *testing.T) {
+	r := require.New(t)
+
+	_, err := ScriptTag("app.js", helptest.NewContext())
+	r.Error(err)
+
+	hc := helptest.NewContext()
+	hc.Set("assets", assets.NewManager(fstest.MapFS{}))
+
+	_, err = ScriptTag("app.js", hc)
+	r.Error(err)
+}
+
+func Test_ScriptTag_Nonce(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("assets", pathOnly{})
+	hc.Set("cspNonce", "r4nd0m")
+
+	s, err := ScriptTag("app.js", hc)
+	r.NoError(err)
+	r.Equal(`<script src="/public/app-abc123.js" nonce="r4nd0m"></script>`, string(s))
+}
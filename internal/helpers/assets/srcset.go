@@ -0,0 +1,36 @@
+package assets
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// Srcset returns the value of a srcset attribute for the passed image with
+// the fingerprinted paths of its variants at each width. Like with picture,
+// variants are expected as "<name>-<width>w.<ext>" files next to the image.
+//
+//	<img src="<%= assets.PathFor("photo.jpg") %>" srcset="<%= srcset("photo.jpg", [480, 960]) %>">
+func Srcset(name string, widths interface{}, help hctx.HelperContext) (string, error) {
+	manager, ok := help.Value("assets").(pather)
+	if !ok {
+		return "", errors.New("srcset: could not find the assets manager in the context")
+	}
+
+	ww, err := pictureWidths(widths)
+	if err != nil {
+		return "", fmt.Errorf("srcset: %w", err)
+	}
+
+	if len(ww) == 0 {
+		return "", errors.New("srcset: no widths passed")
+	}
+
+	srcset, err := pictureSrcset(manager.PathFor, name, ww)
+	if err != nil {
+		return "", fmt.Errorf("srcset: %w", err)
+	}
+
+	return srcset, nil
+}
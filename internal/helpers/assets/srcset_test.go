@@ -0,0 +1,40 @@
+package assets
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Srcset(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("assets", assets.NewManager(fstest.MapFS{
+		"photo.jpg":       {Data: []byte("AAA")},
+		"photo-480w.jpg":  {Data: []byte("BBB")},
+		"photo-960w.jpg":  {Data: []byte("CCC")},
+		"photo-1920w.jpg": {Data: []byte("DDD")},
+	}))
+
+	s, err := Srcset("photo.jpg", []interface{}{480, 960, 1920}, hc)
+	r.NoError(err)
+	r.Equal(
+		"/public/photo-480w-2bb225f0ba9a58930757a868ed57d9a3.jpg 480w, "+
+			"/public/photo-960w-defb99e69a9f1f6e06f15006b1f166ae.jpg 960w, "+
+			"/public/photo-1920w-45054f47ac3305a2a33e9bcceadff712.jpg 1920w",
+		s,
+	)
+
+	_, err = Srcset("photo.jpg", []interface{}{480, 1280}, hc)
+	r.Error(err, "missing variant")
+
+	_, err = Srcset("photo.jpg", []interface{}{}, hc)
+	r.Error(err, "no widths")
+
+	_, err = Srcset("photo.jpg", []interface{}{480}, helptest.NewContext())
+	r.Error(err, "no manager")
+}
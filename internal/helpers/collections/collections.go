@@ -0,0 +1,15 @@
+package collections
+
+import "github.com/leapkit/core/render/hctx"
+
+// Keys to be used in templates for the functions in this package.
+const (
+	ListKey = "list"
+)
+
+// New returns a map of the helpers within this package.
+func New() hctx.Map {
+	return hctx.Map{
+		ListKey: List,
+	}
+}
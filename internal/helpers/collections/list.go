@@ -0,0 +1,13 @@
+package collections
+
+// List returns the passed values as a slice, this is useful to
+// iterate literals or pass a list to a partial.
+//
+//	<%= for (color) in list("red", "green", "blue") { %>
+func List(values ...interface{}) []interface{} {
+	if values == nil {
+		return []interface{}{}
+	}
+
+	return values
+}
@@ -0,0 +1,19 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_List(t *testing.T) {
+	r := require.New(t)
+	r.Equal([]interface{}{"a", 1, true}, List("a", 1, true))
+}
+
+func Test_List_Empty(t *testing.T) {
+	r := require.New(t)
+	l := List()
+	r.NotNil(l)
+	r.Empty(l)
+}
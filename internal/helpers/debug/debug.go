@@ -11,6 +11,7 @@ import (
 const (
 	DebugKey   = "debug"
 	InspectKey = "inspect"
+	DumpKey    = "dump"
 )
 
 // New returns a map of the helpers within this package.
@@ -18,6 +19,7 @@ func New() hctx.Map {
 	return hctx.Map{
 		DebugKey:   Debug,
 		InspectKey: Inspect,
+		DumpKey:    Dump,
 	}
 }
 
@@ -0,0 +1,33 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"strings"
+)
+
+// Dump renders v as indented JSON within 'pre' tags, falling back to
+// the Go syntax representation when v can't be encoded as JSON. It only
+// outputs content when GO_ENV is development, so data doesn't leak in
+// deploys that don't set it.
+//
+//	<%= dump(user) %>
+func Dump(v interface{}) template.HTML {
+	if os.Getenv("GO_ENV") != "development" {
+		return ""
+	}
+
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	out := fmt.Sprintf("%#v", v)
+	if err := enc.Encode(v); err == nil {
+		out = strings.TrimSpace(buf.String())
+	}
+
+	return template.HTML(fmt.Sprintf("<pre>%s</pre>", template.HTMLEscapeString(out)))
+}
@@ -0,0 +1,46 @@
+package debug
+
+import (
+	"html/template"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dump(t *testing.T) {
+	s := struct {
+		Name string
+	}{"<Ringo>"}
+
+	t.Run("development", func(st *testing.T) {
+		st.Setenv("GO_ENV", "development")
+		r := require.New(st)
+
+		out := Dump(s)
+		r.Equal(template.HTML("<pre>{\n  &#34;Name&#34;: &#34;&lt;Ringo&gt;&#34;\n}</pre>"), out)
+	})
+
+	t.Run("not encodable as json", func(st *testing.T) {
+		st.Setenv("GO_ENV", "development")
+		r := require.New(st)
+
+		out := Dump(func() {})
+		r.Contains(out, "<pre>(func())")
+	})
+
+	t.Run("production", func(st *testing.T) {
+		st.Setenv("GO_ENV", "production")
+		r := require.New(st)
+
+		r.Empty(Dump(s))
+	})
+
+	t.Run("unset", func(st *testing.T) {
+		st.Setenv("GO_ENV", "")
+		os.Unsetenv("GO_ENV")
+		r := require.New(st)
+
+		r.Empty(Dump(s))
+	})
+}
@@ -6,6 +6,7 @@ import "github.com/leapkit/core/render/hctx"
 const (
 	ToJSONKey = "toJSON"
 	RawKey    = "raw"
+	JSONLDKey = "jsonLD"
 )
 
 // New returns a map of the helpers within this package.
@@ -14,5 +15,6 @@ func New() hctx.Map {
 		"json":    ToJSON,
 		RawKey:    Raw,
 		ToJSONKey: ToJSON,
+		JSONLDKey: JSONLD,
 	}
 }
@@ -0,0 +1,20 @@
+package encoders
+
+import (
+	"encoding/json"
+	"html/template"
+)
+
+// JSONLD marshals v and wraps it in a <script type="application/ld+json">
+// block for structured data. The marshaled JSON has <, > and & escaped
+// as unicode sequences, so values can't break out of the script.
+//
+//	<%= jsonLD({"@context": "https://schema.org", "@type": "Article", "headline": post.Title}) %>
+func JSONLD(v interface{}) (template.HTML, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return template.HTML(`<script type="application/ld+json">` + string(b) + `</script>`), nil
+}
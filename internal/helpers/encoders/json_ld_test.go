@@ -0,0 +1,40 @@
+package encoders
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JSONLD(t *testing.T) {
+	t.Run("wraps the JSON in a script", func(t *testing.T) {
+		r := require.New(t)
+
+		h, err := JSONLD(map[string]interface{}{
+			"@context": "https://schema.org",
+			"@type":    "Article",
+		})
+
+		r.NoError(err)
+		r.Equal(template.HTML(`<script type="application/ld+json">{"@context":"https://schema.org","@type":"Article"}</script>`), h)
+	})
+
+	t.Run("escapes angle brackets", func(t *testing.T) {
+		r := require.New(t)
+
+		h, err := JSONLD(map[string]string{"headline": "</script><script>alert(1)</script> & more"})
+		r.NoError(err)
+		r.Equal(template.HTML(`<script type="application/ld+json">{"headline":"\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e \u0026 more"}</script>`), h)
+
+		inner := strings.TrimSuffix(strings.TrimPrefix(string(h), `<script type="application/ld+json">`), `</script>`)
+		r.NotContains(inner, "<")
+		r.NotContains(inner, ">")
+	})
+
+	t.Run("errors when the value can't be marshaled", func(t *testing.T) {
+		_, err := JSONLD(func() {})
+		require.Error(t, err)
+	})
+}
@@ -9,15 +9,17 @@ import (
 
 // Keys to be used in templates for the functions in this package.
 const (
-	EnvKey   = "env"
-	EnvOrKey = "envOr"
+	EnvKey     = "env"
+	EnvOrKey   = "envOr"
+	FeatureKey = "feature"
 )
 
 // New returns a map of the helpers within this package.
 func New() hctx.Map {
 	return hctx.Map{
-		EnvKey:   Env,
-		EnvOrKey: EnvOr,
+		EnvKey:     Env,
+		EnvOrKey:   EnvOr,
+		FeatureKey: EnvFlags,
 	}
 }
 
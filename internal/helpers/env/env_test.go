@@ -1 +1,38 @@
 package env
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Env(t *testing.T) {
+	r := require.New(t)
+	t.Setenv("LEAPKIT_TEST_ENV", "test")
+
+	s, err := Env("LEAPKIT_TEST_ENV")
+	r.NoError(err)
+	r.Equal("test", s)
+
+	_, err = Env("LEAPKIT_UNSET_ENV")
+	r.Error(err)
+}
+
+func Test_EnvOr(t *testing.T) {
+	r := require.New(t)
+	t.Setenv("LEAPKIT_TEST_ENV", "test")
+
+	r.Equal("test", EnvOr("LEAPKIT_TEST_ENV", "default"))
+	r.Equal("default", EnvOr("LEAPKIT_UNSET_ENV", "default"))
+}
+
+func Test_EnvFlags(t *testing.T) {
+	r := require.New(t)
+	t.Setenv("FEATURE_NEW_DASHBOARD", "true")
+	t.Setenv("FEATURE_OLD_DASHBOARD", "false")
+
+	r.True(EnvFlags("new_dashboard"))
+	r.True(EnvFlags("new-dashboard"))
+	r.False(EnvFlags("old_dashboard"))
+	r.False(EnvFlags("unset_feature"))
+}
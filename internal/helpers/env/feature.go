@@ -0,0 +1,19 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// EnvFlags is the default source of the feature helper, it reads the
+// flag from the FEATURE_<NAME> environment variable, e.g.
+// FEATURE_NEW_DASHBOARD=true for the "new_dashboard" feature.
+//
+//	<%= if (feature("new_dashboard")) { %>
+func EnvFlags(name string) bool {
+	key := "FEATURE_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	enabled, _ := strconv.ParseBool(os.Getenv(key))
+
+	return enabled
+}
@@ -0,0 +1,23 @@
+package forms
+
+import (
+	"html/template"
+
+	"github.com/leapkit/core/internal/helpers/tags"
+)
+
+// SelectedIf returns the selected attribute when the condition
+// is true, and nothing otherwise.
+//
+//	<option value="ar" <%= selectedIf(user.Country == "ar") %>>Argentina</option>
+func SelectedIf(cond bool) template.HTML {
+	return tags.BoolAttr("selected", cond)
+}
+
+// CheckedIf returns the checked attribute when the condition
+// is true, and nothing otherwise.
+//
+//	<input type="checkbox" name="terms" <%= checkedIf(user.Terms) %>>
+func CheckedIf(cond bool) template.HTML {
+	return tags.BoolAttr("checked", cond)
+}
@@ -0,0 +1,28 @@
+package forms
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AttrIf(t *testing.T) {
+	tcases := []struct {
+		name     string
+		fn       func(bool) template.HTML
+		cond     bool
+		expected template.HTML
+	}{
+		{"selected true", SelectedIf, true, "selected"},
+		{"selected false", SelectedIf, false, ""},
+		{"checked true", CheckedIf, true, "checked"},
+		{"checked false", CheckedIf, false, ""},
+	}
+
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			require.Equal(t, tcase.expected, tcase.fn(tcase.cond))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package forms
+
+import (
+	"html/template"
+	"strings"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+// ErrorFor renders the validation error messages for a field,
+// each one within a span with the "error" class. It returns an
+// empty string when the field has no errors.
+//
+//	<%= errorFor(verrs, "email") %>
+//	<span class="error">This field is required.</span>
+func ErrorFor(verrs validate.Errors, field string) template.HTML {
+	var sb strings.Builder
+	for _, err := range verrs[field] {
+		sb.WriteString(`<span class="error">` + template.HTMLEscapeString(err.Error()) + `</span>`)
+	}
+
+	return template.HTML(sb.String())
+}
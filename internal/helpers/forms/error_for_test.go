@@ -0,0 +1,33 @@
+package forms
+
+import (
+	"errors"
+	"html/template"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ErrorFor(t *testing.T) {
+	verrs := validate.Errors{
+		"email": {errors.New("This field is required."), errors.New("<b>invalid</b>")},
+	}
+
+	table := []struct {
+		verrs validate.Errors
+		field string
+		out   template.HTML
+	}{
+		{verrs, "email", `<span class="error">This field is required.</span><span class="error">&lt;b&gt;invalid&lt;/b&gt;</span>`},
+		{verrs, "name", ""},
+		{nil, "email", ""},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.field, func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, ErrorFor(tt.verrs, tt.field))
+		})
+	}
+}
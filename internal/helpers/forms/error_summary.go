@@ -0,0 +1,43 @@
+package forms
+
+import (
+	"html/template"
+	"slices"
+	"strings"
+
+	"github.com/leapkit/core/form/validate"
+)
+
+// ErrorSummary renders all the validation errors as a list to be placed at
+// the top of a form. The list has the alert role so screen readers announce
+// it, and each message links to its field by id. Fields are sorted by name.
+// It returns an empty string when there are no errors.
+//
+//	<%= errorSummary(verrs) %>
+//	<div class="error-summary" role="alert"><ul><li><a href="#email">This field is required.</a></li></ul></div>
+func ErrorSummary(verrs validate.Errors) template.HTML {
+	fields := make([]string, 0, len(verrs))
+	for field, errs := range verrs {
+		if len(errs) > 0 {
+			fields = append(fields, field)
+		}
+	}
+
+	if len(fields) == 0 {
+		return ""
+	}
+
+	slices.Sort(fields)
+
+	var sb strings.Builder
+	sb.WriteString(`<div class="error-summary" role="alert"><ul>`)
+	for _, field := range fields {
+		for _, err := range verrs[field] {
+			sb.WriteString(`<li><a href="#` + template.HTMLEscapeString(field) + `">` + template.HTMLEscapeString(err.Error()) + `</a></li>`)
+		}
+	}
+
+	sb.WriteString(`</ul></div>`)
+
+	return template.HTML(sb.String())
+}
@@ -0,0 +1,47 @@
+package forms
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/leapkit/core/form/validate"
+	"github.com/leapkit/core/render/hctx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ErrorSummary(t *testing.T) {
+	r := require.New(t)
+
+	verrs := validate.Errors{
+		"name":  {errors.New("This field is required.")},
+		"email": {errors.New("This field is required."), errors.New("<b>invalid</b>")},
+	}
+
+	r.Equal(
+		`<div class="error-summary" role="alert"><ul>`+
+			`<li><a href="#email">This field is required.</a></li>`+
+			`<li><a href="#email">&lt;b&gt;invalid&lt;/b&gt;</a></li>`+
+			`<li><a href="#name">This field is required.</a></li>`+
+			`</ul></div>`,
+		string(ErrorSummary(verrs)),
+	)
+}
+
+func Test_ErrorSummary_LinksInputs(t *testing.T) {
+	r := require.New(t)
+
+	verrs := validate.Errors{"email": {errors.New("This field is required.")}}
+
+	input, err := Input(user{}, "Email", hctx.Map{"errors": verrs})
+	r.NoError(err)
+	r.Contains(string(ErrorSummary(verrs)), `href="#email"`)
+	r.Contains(string(input), `id="email"`)
+}
+
+func Test_ErrorSummary_Empty(t *testing.T) {
+	r := require.New(t)
+
+	r.Empty(ErrorSummary(nil))
+	r.Empty(ErrorSummary(validate.Errors{}))
+	r.Empty(ErrorSummary(validate.Errors{"name": nil}))
+}
@@ -0,0 +1,23 @@
+package forms
+
+import "github.com/leapkit/core/render/hctx"
+
+// Keys to be used in templates for the functions in this package.
+const (
+	InputKey        = "input"
+	ErrorForKey     = "errorFor"
+	ErrorSummaryKey = "errorSummary"
+	SelectedIfKey   = "selectedIf"
+	CheckedIfKey    = "checkedIf"
+)
+
+// New returns a map of the helpers within this package.
+func New() hctx.Map {
+	return hctx.Map{
+		InputKey:        Input,
+		ErrorForKey:     ErrorFor,
+		ErrorSummaryKey: ErrorSummary,
+		SelectedIfKey:   SelectedIf,
+		CheckedIfKey:    CheckedIf,
+	}
+}
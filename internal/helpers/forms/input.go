@@ -0,0 +1,179 @@
+package forms
+
+import (
+	"fmt"
+	"html/template"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leapkit/core/form/validate"
+	"github.com/leapkit/core/render/hctx"
+)
+
+// Input renders an <input> bound to a field of the passed struct. The
+// name comes from the form tag of the field, the value is the current
+// value of the field and the type is inferred from the field type, the
+// id defaults to the name. Extra attributes can be passed in the options, when the errors option
+// contains validation errors for the field the "error" class is added.
+//
+//	<%= input(user, "Email", {type: "email", errors: verrs}) %>
+//	<input type="email" id="email" name="email" value="a@pagano.id" class="error">
+func Input(model interface{}, field string, opts hctx.Map) (template.HTML, error) {
+	rv := reflect.Indirect(reflect.ValueOf(model))
+	if rv.Kind() != reflect.Struct {
+		return "", fmt.Errorf("input: expected a struct, got %T", model)
+	}
+
+	sf, ok := rv.Type().FieldByName(field)
+	if !ok {
+		return "", fmt.Errorf("input: %s has no field %s", rv.Type(), field)
+	}
+
+	fv := rv.FieldByIndex(sf.Index)
+	attrs := map[string]interface{}{}
+	for k, v := range opts {
+		attrs[k] = v
+	}
+
+	name := inputName(sf)
+	if verrs, ok := attrs["errors"].(validate.Errors); ok && len(verrs[name]) > 0 {
+		class := "error"
+		if c, ok := attrs["class"]; ok {
+			class = fmt.Sprint(c) + " error"
+		}
+
+		attrs["class"] = class
+	}
+
+	delete(attrs, "errors")
+
+	inputType, value := inputTypeAndValue(fv)
+	if _, ok := attrs["type"]; !ok {
+		attrs["type"] = inputType
+	}
+
+	if _, ok := attrs["name"]; !ok {
+		attrs["name"] = name
+	}
+
+	// The id allows labels and the error summary to link to the input.
+	if _, ok := attrs["id"]; !ok {
+		attrs["id"] = attrs["name"]
+	}
+
+	_, hasValue := attrs["value"]
+	switch attrs["type"] {
+	case "password":
+		// Passwords are never prefilled.
+	case "checkbox":
+		if !hasValue {
+			attrs["value"] = "true"
+		}
+
+		// Nil pointers are rendered unchecked.
+		if fv.Kind() == reflect.Pointer && fv.IsNil() {
+			break
+		}
+
+		if b, ok := reflect.Indirect(fv).Interface().(bool); ok && b {
+			attrs["checked"] = true
+		}
+	default:
+		if !hasValue {
+			attrs["value"] = value
+		}
+	}
+
+	return template.HTML("<input" + attributes(attrs) + ">"), nil
+}
+
+// inputName returns the name of the field in the form.
+func inputName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("form"), ",")
+	if name == "" || name == "-" {
+		return sf.Name
+	}
+
+	return name
+}
+
+// inputTypeAndValue infers the input type from the kind of
+// the field and formats its value.
+func inputTypeAndValue(fv reflect.Value) (string, string) {
+	if fv.Kind() == reflect.Pointer {
+		// Nil pointers keep the type of the element with no value.
+		if fv.IsNil() {
+			inputType, _ := inputTypeAndValue(reflect.Zero(fv.Type().Elem()))
+			return inputType, ""
+		}
+
+		fv = fv.Elem()
+	}
+
+	if fv.Kind() == reflect.Interface && fv.IsNil() {
+		return "text", ""
+	}
+
+	switch v := fv.Interface().(type) {
+	case time.Time:
+		if v.IsZero() {
+			return "date", ""
+		}
+
+		return "date", v.Format(time.DateOnly)
+	case fmt.Stringer:
+		return "text", v.String()
+	}
+
+	switch fv.Kind() {
+	case reflect.Bool:
+		return "checkbox", ""
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number", fmt.Sprint(fv.Interface())
+	}
+
+	return "text", fmt.Sprint(fv.Interface())
+}
+
+// attributes renders the attributes in a deterministic order, with
+// type, id, name and value first. Boolean attributes are rendered without
+// value when true and omitted when false.
+func attributes(attrs map[string]interface{}) string {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+
+	order := map[string]int{"type": 0, "id": 1, "name": 2, "value": 3}
+	sort.Slice(keys, func(i, j int) bool {
+		oi, iok := order[keys[i]]
+		oj, jok := order[keys[j]]
+		switch {
+		case iok && jok:
+			return oi < oj
+		case iok || jok:
+			return iok
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	var sb strings.Builder
+	for _, k := range keys {
+		if b, ok := attrs[k].(bool); ok {
+			if b {
+				sb.WriteString(" " + template.HTMLEscapeString(k))
+			}
+
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf(` %s="%s"`, template.HTMLEscapeString(k), template.HTMLEscapeString(fmt.Sprint(attrs[k]))))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,109 @@
+package forms
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/leapkit/core/form/validate"
+	"github.com/leapkit/core/render/hctx"
+	"github.com/stretchr/testify/require"
+)
+
+type user struct {
+	Name     string    `form:"name"`
+	Email    string    `form:"email"`
+	Password string    `form:"password"`
+	Age      int       `form:"age"`
+	Admin    bool      `form:"admin"`
+	Birthday time.Time `form:"birthday"`
+	Nickname *string
+}
+
+func Test_Input(t *testing.T) {
+	u := user{
+		Name:     `Antonio "Tony"`,
+		Email:    "a@pagano.id",
+		Password: "secret",
+		Age:      30,
+		Admin:    true,
+		Birthday: time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	table := []struct {
+		field string
+		opts  hctx.Map
+		out   string
+	}{
+		{"Name", hctx.Map{}, `<input type="text" id="name" name="name" value="Antonio &#34;Tony&#34;">`},
+		{"Email", hctx.Map{"type": "email", "class": "input"}, `<input type="email" id="email" name="email" value="a@pagano.id" class="input">`},
+		{"Password", hctx.Map{"type": "password"}, `<input type="password" id="password" name="password">`},
+		{"Age", hctx.Map{"min": 18}, `<input type="number" id="age" name="age" value="30" min="18">`},
+		{"Admin", hctx.Map{}, `<input type="checkbox" id="admin" name="admin" value="true" checked>`},
+		{"Birthday", hctx.Map{}, `<input type="date" id="birthday" name="birthday" value="1990-01-02">`},
+		{"Nickname", hctx.Map{"required": true, "disabled": false}, `<input type="text" id="Nickname" name="Nickname" value="" required>`},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.field, func(st *testing.T) {
+			r := require.New(st)
+			out, err := Input(&u, tt.field, tt.opts)
+			r.NoError(err)
+			r.Equal(tt.out, string(out))
+		})
+	}
+}
+
+func Test_Input_NilPointers(t *testing.T) {
+	var m struct {
+		Accept *bool
+		Count  *int
+		Any    interface{}
+	}
+
+	table := []struct {
+		field string
+		out   string
+	}{
+		{"Accept", `<input type="checkbox" id="Accept" name="Accept" value="true">`},
+		{"Count", `<input type="number" id="Count" name="Count" value="">`},
+		{"Any", `<input type="text" id="Any" name="Any" value="">`},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.field, func(st *testing.T) {
+			r := require.New(st)
+			out, err := Input(m, tt.field, hctx.Map{})
+			r.NoError(err)
+			r.Equal(tt.out, string(out))
+		})
+	}
+}
+
+func Test_Input_Errors(t *testing.T) {
+	r := require.New(t)
+	u := user{Email: "a"}
+	verrs := validate.Errors{"email": {errors.New("invalid email")}}
+
+	out, err := Input(u, "Email", hctx.Map{"errors": verrs})
+	r.NoError(err)
+	r.Equal(`<input type="text" id="email" name="email" value="a" class="error">`, string(out))
+
+	out, err = Input(u, "Email", hctx.Map{"errors": verrs, "class": "input"})
+	r.NoError(err)
+	r.Equal(`<input type="text" id="email" name="email" value="a" class="input error">`, string(out))
+
+	out, err = Input(u, "Name", hctx.Map{"errors": verrs})
+	r.NoError(err)
+	r.Equal(`<input type="text" id="name" name="name" value="">`, string(out))
+}
+
+func Test_Input_Invalid(t *testing.T) {
+	r := require.New(t)
+
+	_, err := Input(user{}, "Unknown", hctx.Map{})
+	r.Error(err)
+
+	_, err = Input("not a struct", "Name", hctx.Map{})
+	r.Error(err)
+}
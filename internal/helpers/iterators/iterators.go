@@ -8,6 +8,8 @@ const (
 	BetweenKey = "between"
 	UntilKey   = "until"
 	GroupByKey = "groupBy"
+	UptoKey    = "upto"
+	RangeOfKey = "rangeOf"
 )
 
 // New returns a map of the helpers within this package.
@@ -17,5 +19,7 @@ func New() hctx.Map {
 		BetweenKey: Between,
 		UntilKey:   Until,
 		GroupByKey: GroupBy,
+		UptoKey:    Upto,
+		RangeOfKey: RangeOf,
 	}
 }
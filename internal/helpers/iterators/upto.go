@@ -0,0 +1,30 @@
+package iterators
+
+// Upto returns the numbers from 0 to n, excluding n.
+// It returns an empty slice when n is not positive.
+//
+//	<%= for (i) in upto(5) { %>★<% } %>
+func Upto(n int) []int {
+	if n <= 0 {
+		return []int{}
+	}
+
+	return RangeOf(0, n)
+}
+
+// RangeOf returns the numbers from start to end, excluding end.
+// When start is greater than end the numbers are returned in
+// descending order, e.g. RangeOf(3, 0) returns [3 2 1].
+func RangeOf(start, end int) []int {
+	step := 1
+	if start > end {
+		step = -1
+	}
+
+	nums := make([]int, 0, (end-start)*step)
+	for i := start; i != end; i += step {
+		nums = append(nums, i)
+	}
+
+	return nums
+}
@@ -0,0 +1,47 @@
+package iterators
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Upto(t *testing.T) {
+	table := []struct {
+		in  int
+		out []int
+	}{
+		{3, []int{0, 1, 2}},
+		{1, []int{0}},
+		{0, []int{}},
+		{-2, []int{}},
+	}
+
+	for _, tt := range table {
+		t.Run(fmt.Sprint(tt.in), func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, Upto(tt.in))
+		})
+	}
+}
+
+func Test_RangeOf(t *testing.T) {
+	table := []struct {
+		start int
+		end   int
+		out   []int
+	}{
+		{1, 4, []int{1, 2, 3}},
+		{-2, 1, []int{-2, -1, 0}},
+		{2, 2, []int{}},
+		{3, 0, []int{3, 2, 1}},
+	}
+
+	for _, tt := range table {
+		t.Run(fmt.Sprintf("%d-%d", tt.start, tt.end), func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, RangeOf(tt.start, tt.end))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package tags
+
+import (
+	"fmt"
+	"html/template"
+)
+
+// Attr renders the attribute with the escaped value, or nothing when
+// the value is nil or empty. This avoids rendering empty attributes.
+//
+//	<a <%= attr("title", link.Title) %>>
+//	<a title="Home">
+func Attr(name string, value interface{}) template.HTML {
+	if value == nil {
+		return ""
+	}
+
+	s := fmt.Sprint(value)
+	if s == "" {
+		return ""
+	}
+
+	return template.HTML(template.HTMLEscapeString(name) + `="` + template.HTMLEscapeString(s) + `"`)
+}
+
+// BoolAttr renders the bare attribute when the condition is true.
+//
+//	<button <%= boolAttr("disabled", order.Sent) %>>
+//	<button disabled>
+func BoolAttr(name string, cond bool) template.HTML {
+	if !cond {
+		return ""
+	}
+
+	return template.HTML(template.HTMLEscapeString(name))
+}
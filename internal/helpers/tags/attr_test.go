@@ -0,0 +1,36 @@
+package tags
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Attr(t *testing.T) {
+	table := []struct {
+		name  string
+		value interface{}
+		out   template.HTML
+	}{
+		{"title", "Home", `title="Home"`},
+		{"title", `"quoted" <b>`, `title="&#34;quoted&#34; &lt;b&gt;"`},
+		{"tabindex", 2, `tabindex="2"`},
+		{"title", "", ""},
+		{"title", nil, ""},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, Attr(tt.name, tt.value))
+		})
+	}
+}
+
+func Test_BoolAttr(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(template.HTML("disabled"), BoolAttr("disabled", true))
+	r.Equal(template.HTML(""), BoolAttr("disabled", false))
+}
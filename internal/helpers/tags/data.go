@@ -0,0 +1,26 @@
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// DataAttr renders a data attribute with the value encoded as JSON and
+// escaped for HTML attributes, to pass server data to scripts safely.
+//
+//	<div <%= dataAttr("user", user) %>>
+//	<div data-user='{&#34;name&#34;:&#34;Antonio&#34;}'>
+func DataAttr(name string, value interface{}) (template.HTML, error) {
+	bb, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("dataAttr: %w", err)
+	}
+
+	if !strings.HasPrefix(name, "data-") {
+		name = "data-" + name
+	}
+
+	return template.HTML(template.HTMLEscapeString(name) + `='` + template.HTMLEscapeString(string(bb)) + `'`), nil
+}
@@ -0,0 +1,37 @@
+package tags
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DataAttr(t *testing.T) {
+	table := []struct {
+		name  string
+		value interface{}
+		out   template.HTML
+	}{
+		{"user", map[string]string{"name": `O'Brien "<b>"`}, `data-user='{&#34;name&#34;:&#34;O&#39;Brien \&#34;\u003cb\u003e\&#34;&#34;}'`},
+		{"data-ids", []int{1, 2}, `data-ids='[1,2]'`},
+		{"count", 3, `data-count='3'`},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(st *testing.T) {
+			r := require.New(st)
+
+			s, err := DataAttr(tt.name, tt.value)
+			r.NoError(err)
+			r.Equal(tt.out, s)
+		})
+	}
+}
+
+func Test_DataAttr_Error(t *testing.T) {
+	r := require.New(t)
+
+	_, err := DataAttr("fn", func() {})
+	r.Error(err)
+}
@@ -0,0 +1,19 @@
+package tags
+
+import "github.com/leapkit/core/render/hctx"
+
+// Keys to be used in templates for the functions in this package.
+const (
+	AttrKey     = "attr"
+	BoolAttrKey = "boolAttr"
+	DataAttrKey = "dataAttr"
+)
+
+// New returns a map of the helpers within this package.
+func New() hctx.Map {
+	return hctx.Map{
+		AttrKey:     Attr,
+		BoolAttrKey: BoolAttr,
+		DataAttrKey: DataAttr,
+	}
+}
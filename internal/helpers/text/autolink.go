@@ -0,0 +1,43 @@
+package text
+
+import (
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+// urlExp matches bare http and https URLs within a text.
+var urlExp = regexp.MustCompile(`https?://[^\s<>"']+`)
+
+// Autolink escapes the text and converts the bare http and https URLs
+// in it into links with rel="nofollow noopener", which is handy to
+// display user comments. Trailing punctuation is left out of the links.
+//
+//	<%= autolink(comment.Body) %>
+func Autolink(text string) template.HTML {
+	var sb strings.Builder
+
+	last := 0
+	for _, loc := range urlExp.FindAllStringIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		link := strings.TrimRight(text[start:end], ".,;:!?)]}")
+		if strings.HasSuffix(link, "://") {
+			continue
+		}
+
+		end = start + len(link)
+
+		sb.WriteString(template.HTMLEscapeString(text[last:start]))
+		sb.WriteString(`<a href="`)
+		sb.WriteString(template.HTMLEscapeString(link))
+		sb.WriteString(`" rel="nofollow noopener">`)
+		sb.WriteString(template.HTMLEscapeString(link))
+		sb.WriteString(`</a>`)
+
+		last = end
+	}
+
+	sb.WriteString(template.HTMLEscapeString(text[last:]))
+
+	return template.HTML(sb.String())
+}
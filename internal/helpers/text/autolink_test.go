@@ -0,0 +1,52 @@
+package text
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Autolink(t *testing.T) {
+	table := []struct {
+		name string
+		text string
+		out  template.HTML
+	}{
+		{"no urls", "hello world", "hello world"},
+		{
+			"url with escaped text",
+			"see <b>this</b> https://leapkit.dev/docs",
+			`see &lt;b&gt;this&lt;/b&gt; <a href="https://leapkit.dev/docs" rel="nofollow noopener">https://leapkit.dev/docs</a>`,
+		},
+		{
+			"angle brackets",
+			"1 < 2 && <script>alert(1)</script>",
+			"1 &lt; 2 &amp;&amp; &lt;script&gt;alert(1)&lt;/script&gt;",
+		},
+		{
+			"query string",
+			"http://example.com/?a=1&b=2",
+			`<a href="http://example.com/?a=1&amp;b=2" rel="nofollow noopener">http://example.com/?a=1&amp;b=2</a>`,
+		},
+		{
+			"trailing punctuation",
+			"Visit https://leapkit.dev. Or (http://example.com)!",
+			`Visit <a href="https://leapkit.dev" rel="nofollow noopener">https://leapkit.dev</a>. Or (<a href="http://example.com" rel="nofollow noopener">http://example.com</a>)!`,
+		},
+		{
+			"url next to a tag",
+			`<a href="x">https://leapkit.dev</a>`,
+			`&lt;a href=&#34;x&#34;&gt;<a href="https://leapkit.dev" rel="nofollow noopener">https://leapkit.dev</a>&lt;/a&gt;`,
+		},
+		{"scheme only", "http://.", "http://."},
+		{"other schemes", "javascript:alert(1)", "javascript:alert(1)"},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, Autolink(tt.text))
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Inflect returns the singular form when count is 1 and the plural
+// form otherwise, replacing the %d placeholder with the count.
+//
+//	<%= inflect(len(items), "%d item", "%d items") %>
+func Inflect(count int, singular, plural string) string {
+	form := plural
+	if count == 1 || count == -1 {
+		form = singular
+	}
+
+	return strings.ReplaceAll(form, "%d", strconv.Itoa(count))
+}
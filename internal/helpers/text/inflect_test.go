@@ -0,0 +1,31 @@
+package text
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Inflect(t *testing.T) {
+	table := []struct {
+		count int
+		out   string
+	}{
+		{0, "0 items"},
+		{1, "1 item"},
+		{2, "2 items"},
+	}
+
+	for _, tt := range table {
+		t.Run(fmt.Sprint(tt.count), func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, Inflect(tt.count, "%d item", "%d items"))
+		})
+	}
+}
+
+func Test_Inflect_NoPlaceholder(t *testing.T) {
+	r := require.New(t)
+	r.Equal("one item", Inflect(1, "one item", "%d items"))
+}
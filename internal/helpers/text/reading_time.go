@@ -0,0 +1,26 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// ReadingTime estimates the time it takes to read the text, like
+// "4 min read". Minutes are rounded up and texts never take less than a
+// minute. It assumes 200 words per minute, which can be changed with the
+// `wpm` option.
+//
+//	<%= readingTime(post.Body, {wpm: 250}) %>
+func ReadingTime(text string, opts hctx.Map) string {
+	wpm := 200
+	if w, ok := opts["wpm"].(int); ok && w > 0 {
+		wpm = w
+	}
+
+	words := len(strings.Fields(text))
+	minutes := max((words+wpm-1)/wpm, 1)
+
+	return fmt.Sprintf("%d min read", minutes)
+}
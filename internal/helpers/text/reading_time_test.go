@@ -0,0 +1,38 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/leapkit/core/render/hctx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadingTime(t *testing.T) {
+	words := func(n int) string {
+		return strings.TrimSpace(strings.Repeat("word ", n))
+	}
+
+	table := []struct {
+		words int
+		opts  hctx.Map
+		out   string
+	}{
+		{0, hctx.Map{}, "1 min read"},
+		{12, hctx.Map{}, "1 min read"},
+		{200, hctx.Map{}, "1 min read"},
+		{201, hctx.Map{}, "2 min read"},
+		{800, hctx.Map{}, "4 min read"},
+		{1750, hctx.Map{}, "9 min read"},
+		{500, hctx.Map{"wpm": 250}, "2 min read"},
+		{501, hctx.Map{"wpm": 250}, "3 min read"},
+	}
+
+	for _, tt := range table {
+		t.Run(fmt.Sprintf("%d words %v", tt.words, tt.opts), func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, ReadingTime(words(tt.words), tt.opts))
+		})
+	}
+}
@@ -4,12 +4,20 @@ import "github.com/leapkit/core/render/hctx"
 
 // Keys to be used in templates for the functions in this package.
 const (
-	TruncateKey = "truncate"
+	TruncateKey    = "truncate"
+	InflectKey     = "inflect"
+	ToSentenceKey  = "toSentence"
+	ReadingTimeKey = "readingTime"
+	AutolinkKey    = "autolink"
 )
 
 // New returns a map of the helpers within this package.
 func New() hctx.Map {
 	return hctx.Map{
-		TruncateKey: Truncate,
+		TruncateKey:    Truncate,
+		InflectKey:     Inflect,
+		ToSentenceKey:  ToSentence,
+		ReadingTimeKey: ReadingTime,
+		AutolinkKey:    Autolink,
 	}
 }
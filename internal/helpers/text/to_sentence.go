@@ -0,0 +1,44 @@
+package text
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// ToSentence joins the items with commas and the conjunction before the
+// last one, like "apples, oranges, and bananas". The conjunction defaults
+// to "and" and can be changed with the `conjunction` option. Two items
+// are joined without comma, like "apples and oranges".
+//
+//	<%= toSentence(fruits, {conjunction: "or"}) %>
+func ToSentence(items interface{}, opts hctx.Map) string {
+	conjunction := "and"
+	if c, ok := opts["conjunction"].(string); ok {
+		conjunction = c
+	}
+
+	rv := reflect.ValueOf(items)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return ""
+	}
+
+	words := make([]string, rv.Len())
+	for i := range words {
+		words[i] = fmt.Sprint(rv.Index(i).Interface())
+	}
+
+	switch len(words) {
+	case 0:
+		return ""
+	case 1:
+		return words[0]
+	case 2:
+		return words[0] + " " + conjunction + " " + words[1]
+	}
+
+	last := len(words) - 1
+	return strings.Join(words[:last], ", ") + ", " + conjunction + " " + words[last]
+}
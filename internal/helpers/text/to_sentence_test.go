@@ -0,0 +1,31 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/leapkit/core/render/hctx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToSentence(t *testing.T) {
+	table := []struct {
+		name  string
+		items interface{}
+		opts  hctx.Map
+		out   string
+	}{
+		{"zero", []string{}, hctx.Map{}, ""},
+		{"one", []string{"apples"}, hctx.Map{}, "apples"},
+		{"two", []string{"apples", "oranges"}, hctx.Map{}, "apples and oranges"},
+		{"three", []string{"apples", "oranges", "bananas"}, hctx.Map{}, "apples, oranges, and bananas"},
+		{"conjunction", []interface{}{"tea", "coffee", 3}, hctx.Map{"conjunction": "or"}, "tea, coffee, or 3"},
+		{"not a slice", "apples", hctx.Map{}, ""},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, ToSentence(tt.items, tt.opts))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package times
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// durationUnits are the units durations are split into,
+// from the largest to the smallest.
+var durationUnits = []struct {
+	size   time.Duration
+	suffix string
+}{
+	{24 * time.Hour, "d"},
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+}
+
+// HumanizeDuration formats the duration with each of its units,
+// e.g. "2h 5m" or "3d 4h 10s". Durations under a second are
+// rounded to milliseconds like "250ms".
+func HumanizeDuration(d time.Duration) string {
+	parts := durationParts(d)
+	if len(parts) == 0 {
+		return subsecond(d)
+	}
+
+	return sign(d) + strings.Join(parts, " ")
+}
+
+// CompactDuration formats the duration with its largest unit only,
+// e.g. "2h" for 2h5m. Durations under a second are rounded to
+// milliseconds like "250ms".
+func CompactDuration(d time.Duration) string {
+	parts := durationParts(d)
+	if len(parts) == 0 {
+		return subsecond(d)
+	}
+
+	return sign(d) + parts[0]
+}
+
+// durationParts returns the non-zero units of the
+// duration, sub-second precision is dropped.
+func durationParts(d time.Duration) []string {
+	if d < 0 {
+		d = -d
+	}
+
+	var parts []string
+	for _, unit := range durationUnits {
+		n := d / unit.size
+		if n == 0 {
+			continue
+		}
+
+		parts = append(parts, fmt.Sprintf("%d%s", n, unit.suffix))
+		d -= n * unit.size
+	}
+
+	return parts
+}
+
+func subsecond(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
+
+func sign(d time.Duration) string {
+	if d < 0 {
+		return "-"
+	}
+
+	return ""
+}
@@ -0,0 +1,50 @@
+package times
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HumanizeDuration(t *testing.T) {
+	table := []struct {
+		in  time.Duration
+		out string
+	}{
+		{0, "0s"},
+		{250 * time.Millisecond, "250ms"},
+		{45 * time.Second, "45s"},
+		{2*time.Hour + 5*time.Minute, "2h 5m"},
+		{2*time.Hour + 5*time.Minute + 300*time.Millisecond, "2h 5m"},
+		{75*time.Hour + 10*time.Second, "3d 3h 10s"},
+		{-90 * time.Minute, "-1h 30m"},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.in.String(), func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, HumanizeDuration(tt.in))
+		})
+	}
+}
+
+func Test_CompactDuration(t *testing.T) {
+	table := []struct {
+		in  time.Duration
+		out string
+	}{
+		{0, "0s"},
+		{250 * time.Millisecond, "250ms"},
+		{2*time.Hour + 5*time.Minute, "2h"},
+		{75*time.Hour + 10*time.Second, "3d"},
+		{-90 * time.Minute, "-1h"},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.in.String(), func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, CompactDuration(tt.in))
+		})
+	}
+}
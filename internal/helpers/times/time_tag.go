@@ -0,0 +1,72 @@
+package times
+
+import (
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// relativeUnits are the units relative times are expressed in,
+// from the largest to the smallest.
+var relativeUnits = []struct {
+	size time.Duration
+	name string
+}{
+	{365 * 24 * time.Hour, "year"},
+	{30 * 24 * time.Hour, "month"},
+	{24 * time.Hour, "day"},
+	{time.Hour, "hour"},
+	{time.Minute, "minute"},
+}
+
+// TimeTag renders a <time> element with the machine readable timestamp in
+// the datetime attribute, the precise time as the title and the time
+// relative to now as its text, like "3 hours ago" or "in 2 days". Now is
+// taken from the "clock" value in the context when it's a func() time.Time.
+//
+//	<%= timeTag(post.CreatedAt) %>
+//	<time datetime="2024-03-15T18:30:00Z" title="2024-03-15 18:30:00 UTC">3 hours ago</time>
+func TimeTag(t time.Time, help hctx.HelperContext) template.HTML {
+	now := time.Now
+	if clock, ok := help.Value("clock").(func() time.Time); ok {
+		now = clock
+	}
+
+	return template.HTML(fmt.Sprintf(
+		`<time datetime="%s" title="%s">%s</time>`,
+		t.Format(time.RFC3339),
+		template.HTMLEscapeString(t.Format("2006-01-02 15:04:05 MST")),
+		relative(t.Sub(now())),
+	))
+}
+
+// relative describes the duration with its largest unit,
+// durations under a minute are described as "just now".
+func relative(d time.Duration) string {
+	past := d < 0
+	if past {
+		d = -d
+	}
+
+	for _, unit := range relativeUnits {
+		n := int(d / unit.size)
+		if n == 0 {
+			continue
+		}
+
+		text := fmt.Sprintf("%d %s", n, unit.name)
+		if n > 1 {
+			text += "s"
+		}
+
+		if past {
+			return text + " ago"
+		}
+
+		return "in " + text
+	}
+
+	return "just now"
+}
@@ -0,0 +1,41 @@
+package times
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TimeTag(t *testing.T) {
+	now := time.Date(2024, 3, 15, 18, 30, 0, 0, time.UTC)
+
+	hc := helptest.NewContext()
+	hc.Set("clock", func() time.Time { return now })
+
+	table := []struct {
+		t   time.Time
+		out string
+	}{
+		{now.Add(-3 * time.Hour), `<time datetime="2024-03-15T15:30:00Z" title="2024-03-15 15:30:00 UTC">3 hours ago</time>`},
+		{now.Add(-time.Minute), `<time datetime="2024-03-15T18:29:00Z" title="2024-03-15 18:29:00 UTC">1 minute ago</time>`},
+		{now.Add(-20 * time.Second), `<time datetime="2024-03-15T18:29:40Z" title="2024-03-15 18:29:40 UTC">just now</time>`},
+		{now.Add(49 * time.Hour), `<time datetime="2024-03-17T19:30:00Z" title="2024-03-17 19:30:00 UTC">in 2 days</time>`},
+		{now.AddDate(-2, 0, 0), `<time datetime="2022-03-15T18:30:00Z" title="2022-03-15 18:30:00 UTC">2 years ago</time>`},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.out, func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, string(TimeTag(tt.t, hc)))
+		})
+	}
+}
+
+func Test_TimeTag_DefaultClock(t *testing.T) {
+	r := require.New(t)
+
+	s := TimeTag(time.Now().Add(-2*time.Hour), helptest.NewContext())
+	r.Contains(string(s), ">2 hours ago</time>")
+}
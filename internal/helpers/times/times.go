@@ -0,0 +1,21 @@
+package times
+
+import "github.com/leapkit/core/render/hctx"
+
+// Keys to be used in templates for the functions in this package.
+const (
+	HumanizeDurationKey = "humanizeDuration"
+	CompactDurationKey  = "compactDuration"
+	FormatTimeInKey     = "formatTimeIn"
+	TimeTagKey          = "timeTag"
+)
+
+// New returns a map of the helpers within this package.
+func New() hctx.Map {
+	return hctx.Map{
+		HumanizeDurationKey: HumanizeDuration,
+		CompactDurationKey:  CompactDuration,
+		FormatTimeInKey:     FormatTimeIn,
+		TimeTagKey:          TimeTag,
+	}
+}
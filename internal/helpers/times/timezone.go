@@ -0,0 +1,27 @@
+package times
+
+import (
+	"time"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// FormatTimeIn converts the time into the named timezone before formatting
+// it with the layout. When tz is empty the "timezone" value in the context
+// is used, which can be set per request, e.g. from the user session. Invalid
+// or missing timezones fall back to UTC.
+//
+//	<%= formatTimeIn(order.CreatedAt, "America/Bogota", "2006-01-02 15:04") %>
+//	<%= formatTimeIn(order.CreatedAt, "", "2006-01-02 15:04") %>
+func FormatTimeIn(t time.Time, tz, layout string, help hctx.HelperContext) string {
+	if tz == "" {
+		tz, _ = help.Value("timezone").(string)
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return t.In(loc).Format(layout)
+}
@@ -0,0 +1,41 @@
+package times
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FormatTimeIn(t *testing.T) {
+	utc := time.Date(2024, 3, 15, 18, 30, 0, 0, time.UTC)
+
+	table := []struct {
+		tz  string
+		out string
+	}{
+		{"America/Bogota", "2024-03-15 13:30 -05"},
+		{"Asia/Tokyo", "2024-03-16 03:30 JST"},
+		{"Invalid/Zone", "2024-03-15 18:30 UTC"},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.tz, func(st *testing.T) {
+			r := require.New(st)
+			r.Equal(tt.out, FormatTimeIn(utc, tt.tz, "2006-01-02 15:04 MST", helptest.NewContext()))
+		})
+	}
+}
+
+func Test_FormatTimeIn_ContextTimezone(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("timezone", "Asia/Tokyo")
+
+	utc := time.Date(2024, 3, 15, 18, 30, 0, 0, time.UTC)
+	r.Equal("2024-03-16 03:30", FormatTimeIn(utc, "", "2006-01-02 15:04", hc))
+	r.Equal("2024-03-15 18:30", FormatTimeIn(utc, "UTC", "2006-01-02 15:04", hc))
+}
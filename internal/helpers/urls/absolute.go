@@ -0,0 +1,48 @@
+package urls
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// AbsoluteURL returns a helper that builds absolute URLs for the passed
+// path, taking the scheme and host from the request. The X-Forwarded-Host
+// header is only honored when its host is in the allowed list, so clients
+// can't make the application generate links to other hosts. The scheme is
+// https when the request uses TLS, X-Forwarded-Proto is only honored
+// along with an allowed X-Forwarded-Host so clients can't downgrade it.
+//
+//	<%= absoluteURL(request, "/users/1") %>
+//	https://example.com/users/1
+func AbsoluteURL(allowedHosts []string) func(r *http.Request, path string) string {
+	return func(r *http.Request, path string) string {
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+
+		host := r.Host
+		if fhost := forwarded(r.Header.Get("X-Forwarded-Host")); slices.Contains(allowedHosts, fhost) {
+			host = fhost
+
+			// The proxy that set the allowed host is trusted with the scheme too.
+			if proto := forwarded(r.Header.Get("X-Forwarded-Proto")); proto == "http" || proto == "https" {
+				scheme = proto
+			}
+		}
+
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+
+		return scheme + "://" + host + path
+	}
+}
+
+// forwarded returns the first value of a forwarded header, proxies
+// append their values separated by commas.
+func forwarded(value string) string {
+	first, _, _ := strings.Cut(value, ",")
+	return strings.ToLower(strings.TrimSpace(first))
+}
@@ -0,0 +1,65 @@
+package urls
+
+import (
+	"crypto/tls"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AbsoluteURL(t *testing.T) {
+	absoluteURL := AbsoluteURL([]string{"example.com"})
+
+	t.Run("http", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "http://localhost:3000/users", nil)
+		r.Equal("http://localhost:3000/users/1", absoluteURL(req, "/users/1"))
+		r.Equal("http://localhost:3000/users/1", absoluteURL(req, "users/1"))
+	})
+
+	t.Run("https", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "https://example.com/users", nil)
+		req.TLS = &tls.ConnectionState{}
+		r.Equal("https://example.com/users/1", absoluteURL(req, "/users/1"))
+
+		req = httptest.NewRequest("GET", "http://10.0.0.5:3000/users", nil)
+		req.Header.Set("X-Forwarded-Host", "example.com")
+		req.Header.Set("X-Forwarded-Proto", "https")
+		r.Equal("https://example.com/users/1", absoluteURL(req, "/users/1"))
+	})
+
+	t.Run("forwarded proto without allowed host", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "https://example.com/users", nil)
+		req.TLS = &tls.ConnectionState{}
+		req.Header.Set("X-Forwarded-Proto", "http")
+		r.Equal("https://example.com/users/1", absoluteURL(req, "/users/1"))
+
+		req = httptest.NewRequest("GET", "http://10.0.0.5:3000/users", nil)
+		req.Header.Set("X-Forwarded-Host", "evil.com")
+		req.Header.Set("X-Forwarded-Proto", "https")
+		r.Equal("http://10.0.0.5:3000/users/1", absoluteURL(req, "/users/1"))
+	})
+
+	t.Run("allowed forwarded host", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "http://10.0.0.5:3000/users", nil)
+		req.Header.Set("X-Forwarded-Host", "example.com, proxy.internal")
+		req.Header.Set("X-Forwarded-Proto", "https")
+		r.Equal("https://example.com/users/1", absoluteURL(req, "/users/1"))
+	})
+
+	t.Run("not allowed forwarded host", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "http://10.0.0.5:3000/users", nil)
+		req.Header.Set("X-Forwarded-Host", "evil.com")
+		r.Equal("http://10.0.0.5:3000/users/1", absoluteURL(req, "/users/1"))
+	})
+}
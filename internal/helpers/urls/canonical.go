@@ -0,0 +1,44 @@
+package urls
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// DefaultTrackingParams are the query parameters the canonical tag strips
+// by default, names ending with * match any parameter with that prefix.
+var DefaultTrackingParams = []string{"utm_*", "gclid", "fbclid", "msclkid", "mc_cid", "mc_eid", "_ga"}
+
+// CanonicalTag returns a helper that renders the canonical link of the
+// request, an absolute URL built like absoluteURL without the tracking
+// query parameters. Remaining parameters are kept in sorted order.
+//
+//	<%= canonicalTag(request) %>
+//	<link rel="canonical" href="https://example.com/posts?page=2">
+func CanonicalTag(allowedHosts, trackingParams []string) func(r *http.Request) template.HTML {
+	absoluteURL := AbsoluteURL(allowedHosts)
+
+	return func(r *http.Request) template.HTML {
+		query := r.URL.Query()
+		for name := range query {
+			tracking := slices.ContainsFunc(trackingParams, func(param string) bool {
+				prefix, wildcard := strings.CutSuffix(param, "*")
+				return name == param || (wildcard && strings.HasPrefix(name, prefix))
+			})
+
+			if tracking {
+				query.Del(name)
+			}
+		}
+
+		href := absoluteURL(r, r.URL.EscapedPath())
+		if len(query) > 0 {
+			href += "?" + query.Encode()
+		}
+
+		return template.HTML(fmt.Sprintf(`<link rel="canonical" href="%s">`, template.HTMLEscapeString(href)))
+	}
+}
@@ -0,0 +1,43 @@
+package urls
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CanonicalTag(t *testing.T) {
+	canonicalTag := CanonicalTag([]string{"example.com"}, DefaultTrackingParams)
+
+	t.Run("strips tracking params", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "http://example.com/posts?utm_source=news&utm_medium=email&page=2&gclid=abc&fbclid=xyz&q=go", nil)
+		r.Equal(`<link rel="canonical" href="http://example.com/posts?page=2&amp;q=go">`, string(canonicalTag(req)))
+	})
+
+	t.Run("without query", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "http://example.com/posts/hello%20world?utm_campaign=launch", nil)
+		r.Equal(`<link rel="canonical" href="http://example.com/posts/hello%20world">`, string(canonicalTag(req)))
+	})
+
+	t.Run("forwarded host", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "http://10.0.0.5:3000/posts", nil)
+		req.Header.Set("X-Forwarded-Host", "example.com")
+		req.Header.Set("X-Forwarded-Proto", "https")
+		r.Equal(`<link rel="canonical" href="https://example.com/posts">`, string(canonicalTag(req)))
+	})
+
+	t.Run("configured params", func(t *testing.T) {
+		r := require.New(t)
+
+		canonicalTag := CanonicalTag(nil, []string{"ref", "session_*"})
+		req := httptest.NewRequest("GET", "http://example.com/posts?ref=home&session_id=1&utm_source=news", nil)
+		r.Equal(`<link rel="canonical" href="http://example.com/posts?utm_source=news">`, string(canonicalTag(req)))
+	})
+}
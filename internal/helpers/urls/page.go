@@ -0,0 +1,26 @@
+package urls
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// PageURL returns the URL of the current request for the page n, keeping
+// the rest of the query parameters. The request is taken from the "request"
+// value in the context, which the server sets for every request.
+//
+//	<a href="<%= pageURL(2) %>">Next</a>
+func PageURL(n int, help hctx.HelperContext) (string, error) {
+	req, ok := help.Value("request").(*http.Request)
+	if !ok || req == nil {
+		return "", errors.New("pageURL: could not find the request in the context")
+	}
+
+	query := req.URL.Query()
+	query.Set("page", strconv.Itoa(n))
+
+	return req.URL.EscapedPath() + "?" + query.Encode(), nil
+}
@@ -0,0 +1,49 @@
+package urls
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PageURL(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("request", httptest.NewRequest("GET", "/users?status=active&page=1&q=john", nil))
+
+	s, err := PageURL(3, hc)
+	r.NoError(err)
+	r.Equal("/users?page=3&q=john&status=active", s)
+}
+
+func Test_PageURL_NoQuery(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("request", httptest.NewRequest("GET", "/users", nil))
+
+	s, err := PageURL(2, hc)
+	r.NoError(err)
+	r.Equal("/users?page=2", s)
+}
+
+func Test_PageURL_EscapedPath(t *testing.T) {
+	r := require.New(t)
+
+	hc := helptest.NewContext()
+	hc.Set("request", httptest.NewRequest("GET", "/files/a%2Fb/my%20docs/%3F", nil))
+
+	s, err := PageURL(2, hc)
+	r.NoError(err)
+	r.Equal("/files/a%2Fb/my%20docs/%3F?page=2", s)
+}
+
+func Test_PageURL_NoRequest(t *testing.T) {
+	r := require.New(t)
+
+	_, err := PageURL(2, helptest.NewContext())
+	r.Error(err)
+}
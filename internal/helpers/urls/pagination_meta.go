@@ -0,0 +1,42 @@
+package urls
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// PaginationMeta renders the <link rel="prev"> and <link rel="next"> tags
+// of a paginated list, using the URLs from pageURL. The prev link is left
+// out on the first page and the next one on the last page.
+//
+//	<%= paginationMeta(page, totalPages) %>
+func PaginationMeta(current, total int, help hctx.HelperContext) (template.HTML, error) {
+	var sb strings.Builder
+
+	links := []struct {
+		rel   string
+		page  int
+		valid bool
+	}{
+		{"prev", current - 1, current > 1},
+		{"next", current + 1, current < total},
+	}
+
+	for _, link := range links {
+		if !link.valid {
+			continue
+		}
+
+		href, err := PageURL(link.page, help)
+		if err != nil {
+			return "", fmt.Errorf("paginationMeta: %w", err)
+		}
+
+		sb.WriteString(fmt.Sprintf(`<link rel="%s" href="%s">`, link.rel, template.HTMLEscapeString(href)))
+	}
+
+	return template.HTML(sb.String()), nil
+}
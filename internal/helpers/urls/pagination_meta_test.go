@@ -0,0 +1,45 @@
+package urls
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PaginationMeta(t *testing.T) {
+	hc := helptest.NewContext()
+	hc.Set("request", httptest.NewRequest("GET", "/users?status=active&page=2", nil))
+
+	table := []struct {
+		name    string
+		current int
+		out     string
+	}{
+		{"first", 1, `<link rel="next" href="/users?page=2&amp;status=active">`},
+		{"middle", 2, `<link rel="prev" href="/users?page=1&amp;status=active"><link rel="next" href="/users?page=3&amp;status=active">`},
+		{"last", 3, `<link rel="prev" href="/users?page=2&amp;status=active">`},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(st *testing.T) {
+			r := require.New(st)
+
+			s, err := PaginationMeta(tt.current, 3, hc)
+			r.NoError(err)
+			r.Equal(tt.out, string(s))
+		})
+	}
+}
+
+func Test_PaginationMeta_SinglePage(t *testing.T) {
+	r := require.New(t)
+
+	s, err := PaginationMeta(1, 1, helptest.NewContext())
+	r.NoError(err)
+	r.Empty(s)
+
+	_, err = PaginationMeta(1, 2, helptest.NewContext())
+	r.Error(err)
+}
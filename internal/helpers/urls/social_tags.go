@@ -0,0 +1,115 @@
+package urls
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/leapkit/core/render/hctx"
+)
+
+// pather is implemented by the assets manager
+// to return the fingerprinted path of an asset.
+type pather interface {
+	PathFor(name string) (string, error)
+}
+
+// OGTags returns a helper that renders the Open Graph <meta property>
+// tags for the passed map, sorted by name. Keys are prefixed with "og:"
+// when they don't have it already. Image values are made absolute like
+// absoluteURL, see socialTags for how these are resolved.
+//
+//	<%= ogTags({title: post.Title, type: "article", image: "images/cover.jpg"}) %>
+//	<meta property="og:image" content="https://example.com/public/images/cover-<hash>.jpg">
+func OGTags(allowedHosts []string) func(tags hctx.Map, help hctx.HelperContext) (template.HTML, error) {
+	return socialTags("ogTags", "property", "og:", allowedHosts)
+}
+
+// TwitterTags returns a helper that renders the Twitter card <meta name>
+// tags for the passed map like ogTags, prefixing the keys with "twitter:".
+//
+//	<%= twitterTags({card: "summary_large_image", image: "images/cover.jpg"}) %>
+func TwitterTags(allowedHosts []string) func(tags hctx.Map, help hctx.HelperContext) (template.HTML, error) {
+	return socialTags("twitterTags", "name", "twitter:", allowedHosts)
+}
+
+// socialTags renders the meta tags with the attr and key prefix. The values
+// of the image keys (e.g. "image" or "og:image:secure_url") are resolved to
+// absolute URLs: asset names go through the assets manager in the "assets"
+// value to get their fingerprinted path, paths starting with "/" are used
+// as they are and absolute URLs are kept.
+func socialTags(helper, attr, prefix string, allowedHosts []string) func(hctx.Map, hctx.HelperContext) (template.HTML, error) {
+	absoluteURL := AbsoluteURL(allowedHosts)
+
+	return func(tags hctx.Map, help hctx.HelperContext) (template.HTML, error) {
+		contents := make(map[string]string, len(tags))
+		for key, value := range tags {
+			if !strings.HasPrefix(key, prefix) {
+				key = prefix + key
+			}
+
+			contents[key] = fmt.Sprint(value)
+		}
+
+		names := make([]string, 0, len(contents))
+		for name := range contents {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		var sb strings.Builder
+		for _, name := range names {
+			content := contents[name]
+
+			if isImageKey(strings.TrimPrefix(name, prefix)) {
+				var err error
+				content, err = imageURL(content, absoluteURL, help)
+				if err != nil {
+					return "", fmt.Errorf("%s: %w", helper, err)
+				}
+			}
+
+			sb.WriteString(fmt.Sprintf(`<meta %s="%s" content="%s">`, attr, template.HTMLEscapeString(name), template.HTMLEscapeString(content)))
+		}
+
+		return template.HTML(sb.String()), nil
+	}
+}
+
+// isImageKey returns whether the unprefixed key holds an image URL.
+func isImageKey(key string) bool {
+	return key == "image" || key == "image:src" || key == "image:url" || key == "image:secure_url"
+}
+
+// imageURL resolves the image to an absolute URL
+// using the request and the assets manager.
+func imageURL(image string, absoluteURL func(*http.Request, string) string, help hctx.HelperContext) (string, error) {
+	if strings.HasPrefix(image, "http://") || strings.HasPrefix(image, "https://") {
+		return image, nil
+	}
+
+	req, ok := help.Value("request").(*http.Request)
+	if !ok || req == nil {
+		return "", errors.New("could not find the request in the context")
+	}
+
+	if strings.HasPrefix(image, "/") {
+		return absoluteURL(req, image), nil
+	}
+
+	manager, ok := help.Value("assets").(pather)
+	if !ok {
+		return "", errors.New("could not find the assets manager in the context")
+	}
+
+	path, err := manager.PathFor(image)
+	if err != nil {
+		return "", err
+	}
+
+	return absoluteURL(req, path), nil
+}
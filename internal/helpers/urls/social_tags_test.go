@@ -0,0 +1,109 @@
+package urls
+
+import (
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+	"github.com/leapkit/core/internal/helpers/helptest"
+	"github.com/leapkit/core/render/hctx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SocialTags(t *testing.T) {
+	hc := helptest.NewContext()
+	hc.Set("request", httptest.NewRequest("GET", "https://example.com/posts/1", nil))
+	hc.Set("assets", assets.NewManager(fstest.MapFS{
+		"images/cover.jpg": {Data: []byte("AAA")},
+	}))
+
+	image := "https://example.com/public/images/cover-e1faffb3e614e6c2fba74296962386b7.jpg"
+
+	t.Run("og tags", func(t *testing.T) {
+		r := require.New(t)
+
+		s, err := OGTags(nil)(hctx.Map{
+			"title":     `Tom & "Jerry"`,
+			"og:type":   "article",
+			"image":     "images/cover.jpg",
+			"site_name": "LeapKit",
+		}, hc)
+
+		r.NoError(err)
+		r.Equal(
+			`<meta property="og:image" content="`+image+`">`+
+				`<meta property="og:site_name" content="LeapKit">`+
+				`<meta property="og:title" content="Tom &amp; &#34;Jerry&#34;">`+
+				`<meta property="og:type" content="article">`,
+			string(s),
+		)
+	})
+
+	t.Run("twitter tags", func(t *testing.T) {
+		r := require.New(t)
+
+		s, err := TwitterTags(nil)(hctx.Map{
+			"card":  "summary_large_image",
+			"image": "images/cover.jpg",
+		}, hc)
+
+		r.NoError(err)
+		r.Equal(
+			`<meta name="twitter:card" content="summary_large_image">`+
+				`<meta name="twitter:image" content="`+image+`">`,
+			string(s),
+		)
+	})
+
+	t.Run("paths and absolute URLs", func(t *testing.T) {
+		r := require.New(t)
+
+		s, err := OGTags(nil)(hctx.Map{
+			"image":            "/uploads/avatar.png",
+			"image:secure_url": "https://cdn.example.com/cover.jpg",
+		}, hc)
+
+		r.NoError(err)
+		r.Equal(
+			`<meta property="og:image" content="https://example.com/uploads/avatar.png">`+
+				`<meta property="og:image:secure_url" content="https://cdn.example.com/cover.jpg">`,
+			string(s),
+		)
+	})
+
+	t.Run("forwarded host", func(t *testing.T) {
+		r := require.New(t)
+
+		req := httptest.NewRequest("GET", "http://10.0.0.5:3000/posts/1", nil)
+		req.Header.Set("X-Forwarded-Host", "example.com")
+		req.Header.Set("X-Forwarded-Proto", "https")
+
+		fc := helptest.NewContext()
+		fc.Set("request", req)
+		fc.Set("assets", hc.Value("assets"))
+
+		s, err := OGTags([]string{"example.com"})(hctx.Map{"image": "images/cover.jpg"}, fc)
+		r.NoError(err)
+		r.Equal(`<meta property="og:image" content="`+image+`">`, string(s))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		r := require.New(t)
+
+		_, err := OGTags(nil)(hctx.Map{"image": "images/missing.jpg"}, hc)
+		r.Error(err, "missing asset")
+
+		_, err = OGTags(nil)(hctx.Map{"image": "images/cover.jpg"}, helptest.NewContext())
+		r.Error(err, "no request")
+
+		nc := helptest.NewContext()
+		nc.Set("request", httptest.NewRequest("GET", "/", nil))
+		_, err = TwitterTags(nil)(hctx.Map{"image": "images/cover.jpg"}, nc)
+		r.Error(err, "no manager")
+
+		s, err := OGTags(nil)(hctx.Map{"title": "Hello"}, helptest.NewContext())
+		r.NoError(err)
+		r.Equal(`<meta property="og:title" content="Hello">`, string(s))
+	})
+}
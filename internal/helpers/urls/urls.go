@@ -0,0 +1,25 @@
+package urls
+
+import "github.com/leapkit/core/render/hctx"
+
+// Keys to be used in templates for the functions in this package.
+const (
+	PageURLKey        = "pageURL"
+	AbsoluteURLKey    = "absoluteURL"
+	CanonicalTagKey   = "canonicalTag"
+	PaginationMetaKey = "paginationMeta"
+	OGTagsKey         = "ogTags"
+	TwitterTagsKey    = "twitterTags"
+)
+
+// New returns a map of the helpers within this package.
+func New() hctx.Map {
+	return hctx.Map{
+		PageURLKey:        PageURL,
+		AbsoluteURLKey:    AbsoluteURL(nil),
+		CanonicalTagKey:   CanonicalTag(nil, DefaultTrackingParams),
+		PaginationMetaKey: PaginationMeta,
+		OGTagsKey:         OGTags(nil),
+		TwitterTagsKey:    TwitterTags(nil),
+	}
+}
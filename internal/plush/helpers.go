@@ -21,6 +21,7 @@ var Helpers = HelperMap{
 
 func init() {
 	Helpers.Add("partial", PartialHelper)
+	Helpers.Add("partialIf", PartialIfHelper)
 }
 
 // HelperContext is an optional last argument to helpers
@@ -52,3 +52,16 @@ func PartialHelper(name string, data map[string]interface{}, help HelperContext)
 
 	return template.HTML(part), err
 }
+
+// PartialIfHelper renders the partial like PartialHelper only when the
+// condition is true, otherwise nothing is rendered. This keeps
+// feature-toggled includes tidy in templates.
+//
+//	<%= partialIf(features.Banner, "shared/banner.html", {}) %>
+func PartialIfHelper(cond bool, name string, data map[string]interface{}, help HelperContext) (template.HTML, error) {
+	if !cond {
+		return "", nil
+	}
+
+	return PartialHelper(name, data, help)
+}
@@ -311,3 +311,39 @@ func Test_PartialHelper_NoDefaultHelperOverride(t *testing.T) {
 	})
 
 }
+
+func Test_PartialIfHelper(t *testing.T) {
+	r := require.New(t)
+
+	help := plush.HelperContext{Context: plush.NewContext()}
+	help.Set("partialFeeder", func(string) (string, error) {
+		return `<div class="banner"><%= name %></div>`, nil
+	})
+
+	html, err := plush.PartialIfHelper(true, "banner", map[string]interface{}{"name": "Sale"}, help)
+	r.NoError(err)
+	r.Equal(`<div class="banner">Sale</div>`, string(html))
+
+	html, err = plush.PartialIfHelper(false, "banner", map[string]interface{}{"name": "Sale"}, help)
+	r.NoError(err)
+	r.Equal("", string(html))
+}
+
+func Test_PartialIfHelper_Template(t *testing.T) {
+	r := require.New(t)
+
+	ctx := plush.NewContext()
+	ctx.Set("partialFeeder", func(string) (string, error) {
+		return `<p>banner</p>`, nil
+	})
+
+	ctx.Set("show", true)
+	html, err := plush.Render(`<%= partialIf(show, "banner", {}) %>`, ctx)
+	r.NoError(err)
+	r.Equal(`<p>banner</p>`, html)
+
+	ctx.Set("show", false)
+	html, err = plush.Render(`<%= partialIf(show, "banner") %>`, ctx)
+	r.NoError(err)
+	r.Equal("", html)
+}
@@ -6,6 +6,8 @@ import (
 	"io/fs"
 	"sync"
 
+	"github.com/leapkit/core/internal/helpers/env"
+	"github.com/leapkit/core/internal/helpers/urls"
 	"github.com/leapkit/core/internal/plush"
 )
 
@@ -18,13 +20,18 @@ func NewEngine(fs fs.FS, options ...Option) *Engine {
 		values:  make(map[string]any),
 		helpers: make(template.FuncMap),
 
-		defaultLayout: "app/layouts/application.html",
+		defaultLayout:  "app/layouts/application.html",
+		trackingParams: urls.DefaultTrackingParams,
 	}
 
 	for _, option := range options {
 		option(e)
 	}
 
+	// Configured helpers are set once all the options ran so
+	// WithHelpers can't override them, whatever the order.
+	e.configureHelpers()
+
 	return e
 }
 
@@ -35,6 +42,29 @@ type Engine struct {
 	moot    sync.Mutex
 	helpers template.FuncMap
 	values  map[string]any
+
+	// featureFlags, forwardedHosts and trackingParams configure the
+	// helpers set by configureHelpers, urlsConfigured tells whether the
+	// URL helpers need to be set.
+	featureFlags   func(name string) bool
+	forwardedHosts []string
+	trackingParams []string
+	urlsConfigured bool
+}
+
+// configureHelpers sets the helpers that depend on the
+// settings of the options, overriding the defaults.
+func (e *Engine) configureHelpers() {
+	if e.featureFlags != nil {
+		e.helpers[env.FeatureKey] = e.featureFlags
+	}
+
+	if e.urlsConfigured {
+		e.helpers[urls.AbsoluteURLKey] = urls.AbsoluteURL(e.forwardedHosts)
+		e.helpers[urls.CanonicalTagKey] = urls.CanonicalTag(e.forwardedHosts, e.trackingParams)
+		e.helpers[urls.OGTagsKey] = urls.OGTags(e.forwardedHosts)
+		e.helpers[urls.TwitterTagsKey] = urls.TwitterTags(e.forwardedHosts)
+	}
 }
 
 func (e *Engine) Set(key string, value any) {
@@ -0,0 +1,69 @@
+package render_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/render"
+)
+
+func TestEngineOptionsOrder(t *testing.T) {
+	templates := fstest.MapFS{
+		"feature.html": {Data: []byte(`<%= feature("beta") %>`)},
+	}
+
+	flags := render.WithFeatureFlags(func(name string) bool { return name == "beta" })
+	orders := map[string][]render.Option{
+		"flags first":   {flags, render.WithHelpers(render.AllHelpers)},
+		"helpers first": {render.WithHelpers(render.AllHelpers), flags},
+	}
+
+	for name, options := range orders {
+		t.Run(name, func(t *testing.T) {
+			e := render.NewEngine(templates, options...)
+
+			out, err := e.RenderHTML("feature.html", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if out != "true" {
+				t.Errorf("Expected the feature flag source to be used, got %q", out)
+			}
+		})
+	}
+}
+
+func TestEngineURLOptionsOrder(t *testing.T) {
+	templates := fstest.MapFS{
+		"urls.html": {Data: []byte(`<%= absoluteURL(request, "/posts") %> <%= canonicalTag(request) %>`)},
+	}
+
+	hosts := render.WithForwardedHosts("example.com")
+	params := render.WithTrackingParams("ref")
+	orders := map[string][]render.Option{
+		"url options first":  {hosts, params, render.WithHelpers(render.AllHelpers)},
+		"helpers first":      {render.WithHelpers(render.AllHelpers), hosts, params},
+		"helpers in between": {hosts, render.WithHelpers(render.AllHelpers), params},
+	}
+
+	for name, options := range orders {
+		t.Run(name, func(t *testing.T) {
+			e := render.NewEngine(templates, options...)
+
+			req := httptest.NewRequest("GET", "http://10.0.0.5:3000/posts?ref=news", nil)
+			req.Header.Set("X-Forwarded-Host", "example.com")
+
+			out, err := e.RenderHTML("urls.html", map[string]any{"request": req})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := `http://example.com/posts <link rel="canonical" href="http://example.com/posts">`
+			if out != expected {
+				t.Errorf("Expected %q, got %q", expected, out)
+			}
+		})
+	}
+}
@@ -1,26 +1,38 @@
 package render
 
 import (
+	"github.com/leapkit/core/internal/helpers/assets"
+	"github.com/leapkit/core/internal/helpers/collections"
 	"github.com/leapkit/core/internal/helpers/content"
 	"github.com/leapkit/core/internal/helpers/debug"
 	"github.com/leapkit/core/internal/helpers/encoders"
 	"github.com/leapkit/core/internal/helpers/env"
 	"github.com/leapkit/core/internal/helpers/escapes"
+	"github.com/leapkit/core/internal/helpers/forms"
 	"github.com/leapkit/core/internal/helpers/iterators"
 	"github.com/leapkit/core/internal/helpers/meta"
+	"github.com/leapkit/core/internal/helpers/tags"
 	"github.com/leapkit/core/internal/helpers/text"
+	"github.com/leapkit/core/internal/helpers/times"
+	"github.com/leapkit/core/internal/helpers/urls"
 	"github.com/leapkit/core/render/hctx"
 )
 
 // AllHelpers contains all of the default helpers for
 // These will be available to all templates.
 var AllHelpers = hctx.Merge(
+	assets.New(),
+	collections.New(),
 	content.New(),
 	debug.New(),
 	encoders.New(),
 	env.New(),
 	escapes.New(),
+	forms.New(),
 	iterators.New(),
 	meta.New(),
+	tags.New(),
 	text.New(),
+	times.New(),
+	urls.New(),
 )
@@ -0,0 +1,76 @@
+package render_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/assets"
+	"github.com/leapkit/core/render"
+)
+
+func TestCSPMiddleware(t *testing.T) {
+	manager := assets.NewManager(fstest.MapFS{
+		"app.js": {Data: []byte("AAA")},
+	})
+
+	engine := render.NewEngine(fstest.MapFS{
+		"app/layouts/application.html": {Data: []byte("<%= yield %>")},
+		"index.html":                   {Data: []byte(`<%= scriptTag("app.js") %>`)},
+	}, render.WithHelpers(render.AllHelpers))
+
+	var nonce string
+	handler := render.CSPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonce = render.NonceFromCtx(r.Context())
+
+		err := engine.RenderWithStatus(w, http.StatusOK, "index.html", map[string]any{
+			"assets":   manager,
+			"cspNonce": nonce,
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+	}))
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if nonce == "" {
+		t.Fatal("Expected a nonce in the context")
+	}
+
+	csp := res.Header().Get("Content-Security-Policy")
+	if !strings.Contains(csp, fmt.Sprintf("'nonce-%s'", nonce)) {
+		t.Errorf("Expected the policy to allow the nonce %s, got %s", nonce, csp)
+	}
+
+	if !strings.Contains(res.Body.String(), fmt.Sprintf(`nonce="%s"`, nonce)) {
+		t.Errorf("Expected the script tag to have the nonce %s, got %s", nonce, res.Body.String())
+	}
+
+	t.Run("nonce changes per request", func(t *testing.T) {
+		first := nonce
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if nonce == first {
+			t.Error("Expected a different nonce for each request")
+		}
+	})
+
+	t.Run("custom policy", func(t *testing.T) {
+		handler := render.CSPMiddleware("script-src 'nonce-{nonce}'")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			nonce = render.NonceFromCtx(r.Context())
+		}))
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if csp := res.Header().Get("Content-Security-Policy"); csp != "script-src 'nonce-"+nonce+"'" {
+			t.Errorf("Expected the custom policy with the nonce, got %s", csp)
+		}
+	})
+}
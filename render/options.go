@@ -20,3 +20,33 @@ func WithHelpers(hps map[string]any) Option {
 		}
 	}
 }
+
+// WithFeatureFlags sets the source used by the feature helper to
+// know whether a feature is enabled. By default flags are read from
+// the FEATURE_<NAME> environment variables.
+func WithFeatureFlags(source func(name string) bool) Option {
+	return func(e *Engine) {
+		e.featureFlags = source
+	}
+}
+
+// WithForwardedHosts sets the hosts the absoluteURL, canonicalTag,
+// ogTags and twitterTags helpers accept from the X-Forwarded-Host header,
+// by default the header is ignored and the host of the request is used.
+func WithForwardedHosts(hosts ...string) Option {
+	return func(e *Engine) {
+		e.forwardedHosts = hosts
+		e.urlsConfigured = true
+	}
+}
+
+// WithTrackingParams sets the query parameters the canonicalTag helper
+// strips from the URL, names ending with * match any parameter with that
+// prefix. By default utm_*, gclid, fbclid, msclkid, mc_cid, mc_eid and
+// _ga are stripped.
+func WithTrackingParams(params ...string) Option {
+	return func(e *Engine) {
+		e.trackingParams = params
+		e.urlsConfigured = true
+	}
+}
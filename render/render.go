@@ -1 +1,30 @@
 package render
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// RenderWithStatus renders the page template from the engine templates
+// within the default layout, like Page.Render does, with the engine values
+// and helpers plus the passed values. The result is written to w with the
+// given status code and the text/html content type. If the page fails to
+// render a 500 is written instead and the error is returned.
+func (e *Engine) RenderWithStatus(w http.ResponseWriter, status int, page string, values map[string]any) error {
+	var buf bytes.Buffer
+	p := e.HTML(&buf)
+	for k, v := range values {
+		p.Set(k, v)
+	}
+
+	if err := p.Render(page); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
@@ -0,0 +1,62 @@
+package render_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/leapkit/core/render"
+)
+
+func TestRenderWithStatus(t *testing.T) {
+	templates := fstest.MapFS{
+		"app/layouts/application.html": {Data: []byte("<main><%= yield %></main>")},
+		"errors/404.html":              {Data: []byte(`<h1><%= name %> (<%= len(list(1, 2)) %>)<%= suffix %></h1><%= partial("errors/help.html") %>`)},
+		"errors/help.html":             {Data: []byte("<p>Go back</p>")},
+		"errors/broken.html":           {Data: []byte("<%= missing() %>")},
+	}
+
+	t.Run("writes the status and page within the layout", func(t *testing.T) {
+		engine := render.NewEngine(templates, render.WithHelpers(render.AllHelpers))
+		engine.Set("suffix", "!")
+
+		res := httptest.NewRecorder()
+		err := engine.RenderWithStatus(res, http.StatusNotFound, "errors/404.html", map[string]any{
+			"name": "Leapkit",
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.Code != http.StatusNotFound {
+			t.Errorf("Expected status code %d, got %d", http.StatusNotFound, res.Code)
+		}
+
+		if ct := res.Header().Get("Content-Type"); ct != "text/html; charset=utf-8" {
+			t.Errorf("Expected text/html content type, got %s", ct)
+		}
+
+		expected := "<main><h1>Leapkit (2)!</h1><p>Go back</p></main>"
+		if body := res.Body.String(); body != expected {
+			t.Errorf("Expected body %s, got %s", expected, body)
+		}
+	})
+
+	t.Run("writes a 500 on error", func(t *testing.T) {
+		engine := render.NewEngine(templates)
+
+		for _, page := range []string{"errors/broken.html", "errors/missing.html"} {
+			res := httptest.NewRecorder()
+			err := engine.RenderWithStatus(res, http.StatusOK, page, nil)
+			if err == nil {
+				t.Fatalf("Expected an error rendering %s", page)
+			}
+
+			if res.Code != http.StatusInternalServerError {
+				t.Errorf("Expected status code %d, got %d", http.StatusInternalServerError, res.Code)
+			}
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package session
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/sessions"
+)
+
+// clientKey is the session key the client fingerprint is stored under.
+const clientKey = "_client"
+
+// verifyClient compares the client fingerprint with the one stored in the
+// session, when these don't match the session is invalidated. The
+// fingerprint of the current client is stored for the next requests.
+func (c *config) verifyClient(session *sessions.Session, r *http.Request) {
+	fingerprint := c.fingerprint(r)
+
+	stored, ok := session.Values[clientKey].(string)
+	if ok && stored != fingerprint {
+		c.invalidate(session)
+	}
+
+	session.Values[clientKey] = fingerprint
+}
+
+// fingerprint returns the hash of the client
+// IP and user agent bound to the session.
+func (c *config) fingerprint(r *http.Request) string {
+	hash := sha256.New()
+	if c.bindIP {
+		hash.Write([]byte(c.clientIP(r)))
+	}
+
+	hash.Write([]byte{0})
+	if c.bindUA {
+		hash.Write([]byte(r.UserAgent()))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// clientIP returns the IP of the request, masked
+// when a mask is set.
+func (c *config) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || c.ipMask <= 0 {
+		return host
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(c.ipMask, 32)).String()
+	}
+
+	return ip.Mask(net.CIDRMask(64, 128)).String()
+}
@@ -0,0 +1,104 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/leapkit/core/session"
+)
+
+func TestBindClient(t *testing.T) {
+	// newHandler returns a handler that stores the user on /login
+	// and reports the stored user on other paths.
+	newHandler := func(options ...session.Option) (http.Handler, *interface{}) {
+		var user interface{}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+			session.FromCtx(r.Context()).Values["user"] = "antonio"
+			w.WriteHeader(http.StatusOK)
+		})
+
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			user = session.FromCtx(r.Context()).Values["user"]
+		})
+
+		return session.Middleware("secret", "leapkit", options...)(mux), &user
+	}
+
+	// request performs a request to path with the cookies of
+	// the login response from the passed client.
+	request := func(h http.Handler, path, addr, ua string, cookies []*http.Cookie) []*http.Cookie {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = addr
+		req.Header.Set("User-Agent", ua)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, req)
+
+		return res.Result().Cookies()
+	}
+
+	t.Run("same client keeps the session", func(t *testing.T) {
+		h, user := newHandler(session.WithBindClient(true, true))
+
+		cookies := request(h, "/login", "192.0.2.10:1234", "Firefox", nil)
+		request(h, "/", "192.0.2.10:5678", "Firefox", cookies)
+
+		if *user != "antonio" {
+			t.Errorf("Expected the session to be kept, got %v", *user)
+		}
+	})
+
+	t.Run("changed user agent invalidates the session", func(t *testing.T) {
+		h, user := newHandler(session.WithBindClient(false, true))
+
+		cookies := request(h, "/login", "192.0.2.10:1234", "Firefox", nil)
+		request(h, "/", "192.0.2.10:1234", "curl", cookies)
+
+		if *user != nil {
+			t.Errorf("Expected the session to be cleared, got %v", *user)
+		}
+	})
+
+	t.Run("changed user agent is ignored when not bound", func(t *testing.T) {
+		h, user := newHandler()
+
+		cookies := request(h, "/login", "192.0.2.10:1234", "Firefox", nil)
+		request(h, "/", "192.0.2.10:1234", "curl", cookies)
+
+		if *user != "antonio" {
+			t.Errorf("Expected the session to be kept, got %v", *user)
+		}
+	})
+
+	t.Run("changed IP invalidates the session", func(t *testing.T) {
+		h, user := newHandler(session.WithBindClient(true, false))
+
+		cookies := request(h, "/login", "192.0.2.10:1234", "Firefox", nil)
+		request(h, "/", "192.0.2.11:1234", "Firefox", cookies)
+
+		if *user != nil {
+			t.Errorf("Expected the session to be cleared, got %v", *user)
+		}
+	})
+
+	t.Run("IP mask tolerates changes", func(t *testing.T) {
+		h, user := newHandler(session.WithBindClient(true, false), session.WithClientIPMask(24))
+
+		cookies := request(h, "/login", "192.0.2.10:1234", "Firefox", nil)
+		request(h, "/", "192.0.2.11:1234", "Firefox", cookies)
+
+		if *user != "antonio" {
+			t.Errorf("Expected the session to be kept, got %v", *user)
+		}
+
+		request(h, "/", "198.51.100.10:1234", "Firefox", cookies)
+		if *user != nil {
+			t.Errorf("Expected the session to be cleared, got %v", *user)
+		}
+	})
+}
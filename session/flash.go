@@ -0,0 +1,54 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// flashValuePrefix namespaces the keys of the structured flashes
+// so these are not mixed with the string flash messages.
+const flashValuePrefix = "_flash_value:"
+
+// AddFlashValue adds a structured value to the request session flashes
+// under the passed key. The value is JSON encoded so any JSON-encodable type can be
+// used without registering it with gob.
+func AddFlashValue(r *http.Request, key string, value any) error {
+	session := FromCtx(r.Context())
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("could not encode flash %s: %w", key, err)
+	}
+
+	session.AddFlash(string(data), flashValuePrefix+key)
+	return nil
+}
+
+// FlashValue reads the structured flash under the passed key from the
+// request session into a value of type T. Like other flashes, it's removed from the session once read,
+// the returned bool is false when there was no flash for the key.
+//
+//	step, ok, err := session.FlashValue[WizardStep](r, "wizard")
+func FlashValue[T any](r *http.Request, key string) (T, bool, error) {
+	session := FromCtx(r.Context())
+
+	var value T
+
+	flashes := session.Flashes(flashValuePrefix + key)
+	if len(flashes) == 0 {
+		return value, false, nil
+	}
+
+	// When the key was flashed more than once the latest value wins.
+	data, ok := flashes[len(flashes)-1].(string)
+	if !ok {
+		return value, false, fmt.Errorf("invalid flash %s", key)
+	}
+
+	if err := json.Unmarshal([]byte(data), &value); err != nil {
+		return value, false, fmt.Errorf("could not decode flash %s: %w", key, err)
+	}
+
+	return value, true, nil
+}
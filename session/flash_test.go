@@ -0,0 +1,75 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/leapkit/core/session"
+)
+
+func TestFlashValue(t *testing.T) {
+	type step struct {
+		Name  string
+		Email string
+		Tags  []string
+	}
+
+	mw := session.Middleware("secret", "leapkit")
+
+	var reads []step
+	var found []bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/wizard/1", func(w http.ResponseWriter, r *http.Request) {
+		err := session.AddFlashValue(r, "wizard", step{
+			Name:  "Antonio",
+			Email: "a@pagano.id",
+			Tags:  []string{"admin"},
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux.HandleFunc("/wizard/2", func(w http.ResponseWriter, r *http.Request) {
+		s, ok, err := session.FlashValue[step](r, "wizard")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		reads = append(reads, s)
+		found = append(found, ok)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := mw(mux)
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/wizard/1", nil))
+	cookies := res.Result().Cookies()
+
+	// The flash is read in the first request and cleared for the second one.
+	for range 2 {
+		req := httptest.NewRequest(http.MethodGet, "/wizard/2", nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		if len(res.Result().Cookies()) > 0 {
+			cookies = res.Result().Cookies()
+		}
+	}
+
+	if !found[0] || reads[0].Name != "Antonio" || reads[0].Email != "a@pagano.id" || len(reads[0].Tags) != 1 {
+		t.Errorf("Expected the flash to be round-tripped, got %v", reads[0])
+	}
+
+	if found[1] {
+		t.Errorf("Expected the flash to be cleared after read, got %v", reads[1])
+	}
+}
@@ -0,0 +1,109 @@
+package session
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// storeCtxKey is the context key of the Store used
+// by the middleware when WithServerSide is enabled.
+var storeCtxKey = "session.store"
+
+// GetOrSet returns the value stored in the request session under the key.
+// When there is no value, init is called and its result is stored in the
+// session, which gets saved along with the response.
+//
+// The get and the set run under a lock of the session. With WithServerSide
+// the value is read from and written to the Store under the lock, so init
+// runs once for concurrent requests of the same session in the instance.
+// Cookie sessions are decoded by each request, so those can only share
+// the value once it's been saved.
+//
+//	cartID := session.GetOrSet(r, "cart_id", func() any { return uuid.Must(uuid.NewV4()).String() })
+func GetOrSet(r *http.Request, key string, init func() any) any {
+	session := FromCtx(r.Context())
+	store, _ := r.Context().Value(storeCtxKey).(Store)
+
+	// Stored sessions are locked by ID as each request has its own copy.
+	shared := store != nil && session.ID != ""
+	lockKey := fmt.Sprintf("%p", session)
+	if shared {
+		lockKey = session.ID
+	}
+
+	unlock := sessionLocks.lock(lockKey)
+	defer unlock()
+
+	if value, ok := session.Values[key]; ok {
+		return value
+	}
+
+	if shared {
+		values, err := store.Load(session.ID)
+		if err != nil {
+			log.Println("error loading the session:", err)
+		}
+
+		if value, ok := values[key]; ok {
+			session.Values[key] = value
+			return value
+		}
+	}
+
+	value := init()
+	session.Values[key] = value
+
+	if shared {
+		maxAge := time.Duration(session.Options.MaxAge) * time.Second
+		if err := store.Save(session.ID, session.Values, maxAge); err != nil {
+			log.Println("error saving the session:", err)
+		}
+	}
+
+	return value
+}
+
+// sessionLocks holds the locks of the sessions running GetOrSet.
+var sessionLocks = &keyedLocks{locks: map[string]*keyedLock{}}
+
+// keyedLocks is a set of mutexes by key, locks are
+// removed once no goroutine holds or waits for them.
+type keyedLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks the key and returns the function to unlock it.
+func (k *keyedLocks) lock(key string) func() {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		k.locks[key] = l
+	}
+
+	l.refs++
+	k.mu.Unlock()
+
+	l.Lock()
+
+	return func() {
+		l.Unlock()
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
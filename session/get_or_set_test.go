@@ -0,0 +1,109 @@
+package session_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/leapkit/core/session"
+)
+
+func TestGetOrSet(t *testing.T) {
+	mw := session.Middleware("secret", "leapkit")
+
+	var calls int
+	var values []any
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for range 2 {
+			values = append(values, session.GetOrSet(r, "cart_id", func() any {
+				calls++
+				return fmt.Sprintf("cart-%d", calls)
+			}))
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range res.Result().Cookies() {
+		req.AddCookie(c)
+	}
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if calls != 1 {
+		t.Errorf("Expected the initializer to run once, ran %d times", calls)
+	}
+
+	for _, v := range values {
+		if v != "cart-1" {
+			t.Errorf("Expected the cached value cart-1, got %v", values)
+			break
+		}
+	}
+}
+
+func TestGetOrSetConcurrent(t *testing.T) {
+	mw := session.Middleware("secret", "leapkit", session.WithServerSide())
+
+	var calls atomic.Int32
+	var mu sync.Mutex
+	values := map[any]bool{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		session.FromCtx(r.Context()).Values["user"] = "antonio"
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux.HandleFunc("/cart", func(w http.ResponseWriter, r *http.Request) {
+		value := session.GetOrSet(r, "cart_id", func() any {
+			// Slow initializers widen the window for races.
+			time.Sleep(10 * time.Millisecond)
+			return fmt.Sprintf("cart-%d", calls.Add(1))
+		})
+
+		mu.Lock()
+		values[value] = true
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := mw(mux)
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/login", nil))
+	cookies := res.Result().Cookies()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req := httptest.NewRequest(http.MethodGet, "/cart", nil)
+			for _, c := range cookies {
+				req.AddCookie(c)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		}()
+	}
+
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("Expected the initializer to run once, ran %d times", n)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected all the requests to get the same value, got %v", values)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/gob"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/gorilla/sessions"
@@ -24,17 +25,42 @@ var InCtx = Middleware
 // and also takes care of saving the session when the response is written
 // to the client by wrapping the response writer.
 func Middleware(secret, name string, options ...Option) func(http.Handler) http.Handler {
-	store := sessions.NewCookieStore([]byte(secret))
+	cfg := &config{
+		store: sessions.NewCookieStore([]byte(secret)),
+		now:   time.Now,
+	}
 
 	// Run the options on the store
 	for _, option := range options {
-		option(store)
+		option(cfg)
+	}
+
+	var store sessions.Store = cfg.store
+	if cfg.serverSide {
+		if cfg.values == nil {
+			cfg.values = NewMemoryStore()
+		}
+
+		store = &serverStore{cookies: cfg.store, values: cfg.values}
 	}
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			session, _ := store.Get(r, name)
-			r = r.WithContext(context.WithValue(r.Context(), ctxKey, session))
+			if cfg.idleTimeout > 0 || cfg.absoluteTimeout > 0 {
+				cfg.verifyTimeouts(session)
+			}
+
+			if cfg.bindIP || cfg.bindUA {
+				cfg.verifyClient(session, r)
+			}
+
+			ctx := context.WithValue(r.Context(), ctxKey, session)
+			if cfg.serverSide {
+				ctx = context.WithValue(ctx, storeCtxKey, cfg.values)
+			}
+
+			r = r.WithContext(ctx)
 			w = &saver{
 				w:     w,
 				req:   r,
@@ -1,15 +1,87 @@
 package session
 
-import "github.com/gorilla/sessions"
+import (
+	"time"
+
+	"github.com/gorilla/sessions"
+)
 
 // Option for the session middleware
-type Option func(*sessions.CookieStore)
+type Option func(*config)
+
+// config holds the session store and the settings
+// the options can change.
+type config struct {
+	store *sessions.CookieStore
+
+	serverSide bool
+	values     Store
+
+	bindIP bool
+	bindUA bool
+	ipMask int
+
+	idleTimeout     time.Duration
+	absoluteTimeout time.Duration
+	now             func() time.Time
+}
+
+// WithCookieStore runs fn on the cookie store of the session, this allows
+// to set the cookie options the package doesn't provide an option for,
+// like Secure, HttpOnly, SameSite or MaxAge.
+//
+//	session.WithCookieStore(func(store *sessions.CookieStore) {
+//		store.Options.Secure = true
+//		store.Options.SameSite = http.SameSiteLaxMode
+//	})
+func WithCookieStore(fn func(*sessions.CookieStore)) Option {
+	return func(c *config) {
+		fn(c.store)
+	}
+}
 
 // Set the domain for the application session
 // This is useful when you want to share the session
 // between subdomains.
 func WithDomain(domain string) Option {
-	return func(store *sessions.CookieStore) {
-		store.Options.Domain = domain
+	return func(c *config) {
+		c.store.Options.Domain = domain
+	}
+}
+
+// WithBindClient binds the session to the client IP and/or user agent,
+// a hash of these is stored in the session and when it changes on a later
+// request the session values are cleared. This makes stolen session cookies
+// harder to use from other clients.
+func WithBindClient(ip, ua bool) Option {
+	return func(c *config) {
+		c.bindIP = ip
+		c.bindUA = ua
+	}
+}
+
+// WithClientIPMask makes the IP binding tolerant of address changes, e.g.
+// behind proxies or mobile networks, by only comparing the first bits of
+// IPv4 addresses. IPv6 addresses are compared by their /64 prefix when
+// a mask is set. By default the whole address is compared.
+func WithClientIPMask(bits int) Option {
+	return func(c *config) {
+		c.ipMask = bits
+	}
+}
+
+// WithIdleTimeout invalidates the session when no request was made with
+// it for the passed duration. Each request resets the idle time.
+func WithIdleTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.idleTimeout = d
+	}
+}
+
+// WithAbsoluteTimeout invalidates the session once the passed duration
+// has passed since it was created, regardless of its activity.
+func WithAbsoluteTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.absoluteTimeout = d
 	}
 }
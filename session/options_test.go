@@ -0,0 +1,37 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/sessions"
+	"github.com/leapkit/core/session"
+)
+
+func TestWithCookieStore(t *testing.T) {
+	mw := session.Middleware("secret", "leapkit", session.WithCookieStore(func(store *sessions.CookieStore) {
+		store.Options.Secure = true
+		store.Options.HttpOnly = true
+		store.Options.SameSite = http.SameSiteStrictMode
+		store.Options.MaxAge = 3600
+	}))
+
+	h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session.FromCtx(r.Context()).Values["user"] = "antonio"
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	cookies := res.Result().Cookies()
+	if len(cookies) == 0 {
+		t.Fatal("Expected the session cookie to be set")
+	}
+
+	c := cookies[0]
+	if !c.Secure || !c.HttpOnly || c.SameSite != http.SameSiteStrictMode || c.MaxAge != 3600 {
+		t.Errorf("Expected the cookie options to be applied, got %+v", c)
+	}
+}
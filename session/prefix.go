@@ -0,0 +1,50 @@
+package session
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Keys returns the sorted keys of the request session values
+// that start with the prefix, e.g. "wizard." for a wizard flow.
+// Keys reserved by the package, like the timeouts, are skipped.
+func Keys(r *http.Request, prefix string) []string {
+	session := FromCtx(r.Context())
+
+	var keys []string
+	for k := range session.Values {
+		key, ok := k.(string)
+		if !ok || !strings.HasPrefix(key, prefix) || reserved(key) {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// DeletePrefix removes the request session values with keys starting
+// with the prefix, the session gets saved along with the response.
+// Keys reserved by the package are left in place.
+//
+//	session.DeletePrefix(r, "wizard.")
+func DeletePrefix(r *http.Request, prefix string) {
+	session := FromCtx(r.Context())
+	for _, key := range Keys(r, prefix) {
+		delete(session.Values, key)
+	}
+}
+
+// reserved reports whether the key is one the package uses to keep
+// track of the session, like the timeouts, client and tokens.
+func reserved(key string) bool {
+	switch key {
+	case createdAtKey, lastSeenAtKey, clientKey:
+		return true
+	}
+
+	return strings.HasPrefix(key, tokenPrefix)
+}
@@ -0,0 +1,127 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/leapkit/core/session"
+)
+
+func TestDeletePrefix(t *testing.T) {
+	mw := session.Middleware("secret", "leapkit")
+
+	var before, after []string
+	var user interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/start", func(w http.ResponseWriter, r *http.Request) {
+		values := session.FromCtx(r.Context()).Values
+		values["wizard.step1"] = "name"
+		values["wizard.step2"] = "email"
+		values["wizards"] = "other"
+		values["user"] = "antonio"
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux.HandleFunc("/finish", func(w http.ResponseWriter, r *http.Request) {
+		before = session.Keys(r, "wizard.")
+		session.DeletePrefix(r, "wizard.")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux.HandleFunc("/check", func(w http.ResponseWriter, r *http.Request) {
+		after = session.Keys(r, "")
+		user = session.FromCtx(r.Context()).Values["user"]
+	})
+
+	handler := mw(mux)
+
+	cookies := []*http.Cookie{}
+	for _, path := range []string{"/start", "/finish", "/check"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		if len(res.Result().Cookies()) > 0 {
+			cookies = res.Result().Cookies()
+		}
+	}
+
+	if !slices.Equal(before, []string{"wizard.step1", "wizard.step2"}) {
+		t.Errorf("Expected the prefixed keys, got %v", before)
+	}
+
+	if !slices.Equal(after, []string{"user", "wizards"}) {
+		t.Errorf("Expected only the prefixed keys to be removed, got %v", after)
+	}
+
+	if user != "antonio" {
+		t.Errorf("Expected the other values to remain, got %v", user)
+	}
+}
+
+func TestDeletePrefixReserved(t *testing.T) {
+	mw := session.Middleware("secret", "leapkit", session.WithAbsoluteTimeout(time.Hour), session.WithBindClient(false, true))
+
+	var keys []string
+	var token bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/start", func(w http.ResponseWriter, r *http.Request) {
+		session.FromCtx(r.Context()).Values["user"] = "antonio"
+		session.IssueToken(r, "confirm")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux.HandleFunc("/reset", func(w http.ResponseWriter, r *http.Request) {
+		keys = session.Keys(r, "_")
+		session.DeletePrefix(r, "")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux.HandleFunc("/check", func(w http.ResponseWriter, r *http.Request) {
+		values := session.FromCtx(r.Context()).Values
+		_, token = values["_token:confirm"]
+		keys = append(keys, session.Keys(r, "")...)
+
+		if _, ok := values["_created_at"]; !ok {
+			t.Error("Expected the created at timestamp to remain")
+		}
+
+		if _, ok := values["_client"]; !ok {
+			t.Error("Expected the client binding to remain")
+		}
+	})
+
+	handler := mw(mux)
+
+	cookies := []*http.Cookie{}
+	for _, path := range []string{"/start", "/reset", "/check"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		if len(res.Result().Cookies()) > 0 {
+			cookies = res.Result().Cookies()
+		}
+	}
+
+	if len(keys) > 0 {
+		t.Errorf("Expected the reserved keys not to be listed or the others to be removed, got %v", keys)
+	}
+
+	if !token {
+		t.Error("Expected the token to remain")
+	}
+}
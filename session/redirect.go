@@ -0,0 +1,16 @@
+package session
+
+import (
+	"net/http"
+)
+
+// Redirect adds a flash message to the session under the passed category
+// and redirects to the url with a 303 status. The session middleware saves
+// the session before the redirect is written, so the flash is available in
+// the next request.
+func Redirect(w http.ResponseWriter, r *http.Request, url, category, msg string) {
+	session := FromCtx(r.Context())
+	session.AddFlash(msg, category)
+
+	http.Redirect(w, r, url, http.StatusSeeOther)
+}
@@ -0,0 +1,53 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/leapkit/core/session"
+)
+
+func TestRedirect(t *testing.T) {
+	mw := session.Middleware("secret", "leapkit")
+
+	var flashes []interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		session.Redirect(w, r, "/users/1", "success", "User created")
+	})
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		flashes = session.FromCtx(r.Context()).Flashes("success")
+	})
+
+	handler := mw(mux)
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/users", nil))
+
+	if res.Code != http.StatusSeeOther {
+		t.Errorf("Expected status code %d, got %d", http.StatusSeeOther, res.Code)
+	}
+
+	if loc := res.Header().Get("Location"); loc != "/users/1" {
+		t.Errorf("Expected location /users/1, got %s", loc)
+	}
+
+	if n := len(res.Header().Values("Set-Cookie")); n != 1 {
+		t.Fatalf("Expected exactly one Set-Cookie header, got %d", n)
+	}
+
+	cookies := res.Result().Cookies()
+
+	req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(flashes) != 1 || flashes[0] != "User created" {
+		t.Errorf("Expected the flash to be persisted, got %v", flashes)
+	}
+}
@@ -12,38 +12,42 @@ import (
 
 // saver takes care of automatically saving the session
 // when the response is written, this avoids having to
-// call session.Save() in every handler.
+// call session.Save() in every handler. The session is
+// saved once, right before the headers are written.
 type saver struct {
 	w http.ResponseWriter
 
 	req   *http.Request
 	store *sessions.Session
+	saved bool
 	moot  sync.Mutex
 }
 
 func (s *saver) Header() http.Header {
-	s.moot.Lock()
-	defer s.moot.Unlock()
-
-	s.store.Save(s.req, s.w)
 	return s.w.Header()
 }
 
 func (s *saver) WriteHeader(code int) {
-	s.moot.Lock()
-	defer s.moot.Unlock()
-
-	s.store.Save(s.req, s.w)
+	s.save()
 	s.w.WriteHeader(code)
 }
 
 func (s *saver) Write(b []byte) (int, error) {
+	s.save()
+	return s.w.Write(b)
+}
+
+// save saves the session the first time it's called.
+func (s *saver) save() {
 	s.moot.Lock()
 	defer s.moot.Unlock()
 
+	if s.saved {
+		return
+	}
+
+	s.saved = true
 	s.store.Save(s.req, s.w)
-	n, err := s.w.Write(b)
-	return n, err
 }
 
 func (s *saver) Hijack() (net.Conn, *bufio.ReadWriter, error) {
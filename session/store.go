@@ -0,0 +1,238 @@
+package session
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"log"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+)
+
+// Store keeps the session values on the server side when the session is
+// configured with WithServerSide, the cookie only holds the session ID.
+// Implementations can use a database or a cache like Redis to share the
+// sessions between instances and invalidate them from the server.
+type Store interface {
+	// Load returns the values of the session with the ID, or nil
+	// values when the session does not exist or has expired.
+	Load(id string) (map[interface{}]interface{}, error)
+
+	// Save stores the values of the session with the ID, these
+	// should expire after maxAge when it's greater than zero.
+	Save(id string, values map[interface{}]interface{}, maxAge time.Duration) error
+
+	// Delete removes the session with the ID.
+	Delete(id string) error
+}
+
+// WithServerSide makes the session cookie only hold a signed session ID
+// while the values are kept in the store set with WithStore, or in memory
+// when no store is set. This keeps the cookies small and allows to
+// invalidate sessions by deleting them from the store.
+func WithServerSide() Option {
+	return func(c *config) {
+		c.serverSide = true
+	}
+}
+
+// WithStore sets the store used for the session values
+// when WithServerSide is enabled.
+func WithStore(store Store) Option {
+	return func(c *config) {
+		c.values = store
+	}
+}
+
+// invalidate clears the session values. In server-side mode the stored
+// session is deleted too and a new ID is issued when the session is saved,
+// so the old ID can't be used again.
+func (c *config) invalidate(session *sessions.Session) {
+	session.Values = map[interface{}]interface{}{}
+	if !c.serverSide || session.ID == "" {
+		return
+	}
+
+	if err := c.values.Delete(session.ID); err != nil {
+		log.Println("error deleting the session:", err)
+	}
+
+	session.ID = ""
+}
+
+// memoryStore keeps the session values in memory, expired
+// sessions are removed when loaded and swept once a minute.
+type memoryStore struct {
+	mu        sync.Mutex
+	sessions  map[string]memoryEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type memoryEntry struct {
+	values  map[interface{}]interface{}
+	expires time.Time
+}
+
+// NewMemoryStore returns a Store that keeps the session values in memory.
+// Sessions are lost when the application restarts and are not shared
+// between instances, so it's mostly useful for development and tests.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		sessions: map[string]memoryEntry{},
+		now:      time.Now,
+	}
+}
+
+func (s *memoryStore) Load(id string) (map[interface{}]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+
+	if !entry.expires.IsZero() && s.now().After(entry.expires) {
+		delete(s.sessions, id)
+		return nil, nil
+	}
+
+	// Values are copied so requests sharing the session
+	// don't modify the stored map concurrently.
+	return maps.Clone(entry.values), nil
+}
+
+func (s *memoryStore) Save(id string, values map[interface{}]interface{}, maxAge time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) > time.Minute {
+		for k, entry := range s.sessions {
+			if !entry.expires.IsZero() && now.After(entry.expires) {
+				delete(s.sessions, k)
+			}
+		}
+
+		s.lastSweep = now
+	}
+
+	entry := memoryEntry{values: maps.Clone(values)}
+	if maxAge > 0 {
+		entry.expires = now.Add(maxAge)
+	}
+
+	s.sessions[id] = entry
+	return nil
+}
+
+func (s *memoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, id)
+	return nil
+}
+
+// serverStore is the sessions.Store used with WithServerSide, it signs
+// the session ID with the cookie store codecs and keeps the values in
+// the Store.
+type serverStore struct {
+	cookies *sessions.CookieStore
+	values  Store
+}
+
+func (s *serverStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(s, name)
+}
+
+func (s *serverStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	session := sessions.NewSession(s, name)
+	opts := *s.cookies.Options
+	session.Options = &opts
+	session.IsNew = true
+
+	c, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+
+	var id string
+	err = securecookie.DecodeMulti(name, c.Value, &id, s.cookies.Codecs...)
+	if err != nil {
+		return session, err
+	}
+
+	values, err := s.values.Load(id)
+	if err != nil || values == nil {
+		return session, err
+	}
+
+	session.ID = id
+	session.Values = values
+	session.IsNew = false
+
+	return session, nil
+}
+
+func (s *serverStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	if session.Options.MaxAge < 0 {
+		if session.ID != "" {
+			if err := s.values.Delete(session.ID); err != nil {
+				return err
+			}
+		}
+
+		http.SetCookie(w, sessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+
+	if session.ID == "" {
+		// Sessions without values aren't stored, otherwise each
+		// anonymous request would add an entry to the store.
+		if !hasValues(session) {
+			return nil
+		}
+
+		bb := make([]byte, 32)
+		if _, err := rand.Read(bb); err != nil {
+			return err
+		}
+
+		session.ID = base64.RawURLEncoding.EncodeToString(bb)
+	}
+
+	maxAge := time.Duration(session.Options.MaxAge) * time.Second
+	err := s.values.Save(session.ID, session.Values, maxAge)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, s.cookies.Codecs...)
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, sessions.NewCookie(session.Name(), encoded, session.Options))
+	return nil
+}
+
+// hasValues reports whether the session holds values other than the
+// ones the package uses to keep track of it, like the timeouts.
+func hasValues(session *sessions.Session) bool {
+	for k := range session.Values {
+		switch k {
+		case createdAtKey, lastSeenAtKey, clientKey:
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,225 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/leapkit/core/session"
+)
+
+// recordingStore wraps a Store keeping the last saved ID
+// and the number of saves.
+type recordingStore struct {
+	session.Store
+	lastID string
+	saves  int
+}
+
+func (s *recordingStore) Save(id string, values map[interface{}]interface{}, maxAge time.Duration) error {
+	s.lastID = id
+	s.saves++
+	return s.Store.Save(id, values, maxAge)
+}
+
+func TestServerSide(t *testing.T) {
+	payload := strings.Repeat("x", 2048)
+
+	newHandler := func(options ...session.Option) (http.Handler, *interface{}) {
+		var got interface{}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/set", func(w http.ResponseWriter, r *http.Request) {
+			session.FromCtx(r.Context()).Values["payload"] = payload
+			w.WriteHeader(http.StatusOK)
+		})
+
+		mux.HandleFunc("/get", func(w http.ResponseWriter, r *http.Request) {
+			got = session.FromCtx(r.Context()).Values["payload"]
+			w.WriteHeader(http.StatusOK)
+		})
+
+		return session.Middleware("secret", "leapkit", options...)(mux), &got
+	}
+
+	request := func(h http.Handler, path string, cookies []*http.Cookie) []*http.Cookie {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, req)
+
+		if len(res.Result().Cookies()) == 0 {
+			return cookies
+		}
+
+		return res.Result().Cookies()
+	}
+
+	t.Run("cookie holds no payload", func(t *testing.T) {
+		h, _ := newHandler(session.WithServerSide())
+		cookies := request(h, "/set", nil)
+		if len(cookies) == 0 {
+			t.Fatal("Expected the session cookie to be set")
+		}
+
+		if l := len(cookies[0].Value); l > 200 {
+			t.Errorf("Expected the cookie to only hold the ID, got %d bytes", l)
+		}
+	})
+
+	t.Run("values round-trip via the store", func(t *testing.T) {
+		store := &recordingStore{Store: session.NewMemoryStore()}
+		h, got := newHandler(session.WithServerSide(), session.WithStore(store))
+
+		cookies := request(h, "/set", nil)
+		request(h, "/get", cookies)
+
+		if *got != payload {
+			t.Errorf("Expected the payload to be read from the store, got %v", *got)
+		}
+
+		values, err := store.Load(store.lastID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if values["payload"] != payload {
+			t.Errorf("Expected the payload to be in the store, got %v", values["payload"])
+		}
+	})
+
+	t.Run("deleting from the store invalidates the session", func(t *testing.T) {
+		store := &recordingStore{Store: session.NewMemoryStore()}
+		h, got := newHandler(session.WithServerSide(), session.WithStore(store))
+
+		cookies := request(h, "/set", nil)
+		if err := store.Delete(store.lastID); err != nil {
+			t.Fatal(err)
+		}
+
+		request(h, "/get", cookies)
+		if *got != nil {
+			t.Errorf("Expected the session to be invalidated, got %v", *got)
+		}
+	})
+
+	t.Run("saves once per response", func(t *testing.T) {
+		store := &recordingStore{Store: session.NewMemoryStore()}
+		h := session.Middleware("secret", "leapkit", session.WithServerSide(), session.WithStore(store))(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				session.FromCtx(r.Context()).Values["user"] = "antonio"
+				w.Header().Set("Content-Type", "text/plain")
+				for range 3 {
+					w.Write([]byte("chunk"))
+				}
+			}),
+		)
+
+		request(h, "/", nil)
+		if store.saves != 1 {
+			t.Errorf("Expected the session to be saved once, got %d saves", store.saves)
+		}
+	})
+
+	t.Run("invalidation deletes the stored session", func(t *testing.T) {
+		store := &recordingStore{Store: session.NewMemoryStore()}
+
+		var got interface{}
+		h := session.Middleware("secret", "leapkit", session.WithServerSide(), session.WithStore(store), session.WithBindClient(false, true))(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				s := session.FromCtx(r.Context())
+				got = s.Values["payload"]
+				s.Values["payload"] = r.UserAgent()
+				w.WriteHeader(http.StatusOK)
+			}),
+		)
+
+		login := httptest.NewRequest(http.MethodGet, "/", nil)
+		login.Header.Set("User-Agent", "Firefox")
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, login)
+
+		oldID := store.lastID
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("User-Agent", "Chrome")
+		for _, c := range res.Result().Cookies() {
+			req.AddCookie(c)
+		}
+
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		if got != nil {
+			t.Errorf("Expected the session values to be cleared, got %v", got)
+		}
+
+		values, err := store.Load(oldID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if values != nil {
+			t.Errorf("Expected the old session to be deleted, got %v", values)
+		}
+
+		if store.lastID == oldID {
+			t.Error("Expected a new session ID to be issued")
+		}
+	})
+
+	t.Run("sessions without values are not stored", func(t *testing.T) {
+		store := &recordingStore{Store: session.NewMemoryStore()}
+		h, _ := newHandler(session.WithServerSide(), session.WithStore(store), session.WithIdleTimeout(time.Hour), session.WithAbsoluteTimeout(time.Hour), session.WithBindClient(false, true))
+
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/get", nil))
+		if store.saves != 0 {
+			t.Errorf("Expected the empty session not to be saved, got %d saves", store.saves)
+		}
+
+		if n := len(res.Result().Cookies()); n != 0 {
+			t.Errorf("Expected no session cookie, got %d", n)
+		}
+	})
+
+	t.Run("tampered IDs are rejected", func(t *testing.T) {
+		h, got := newHandler(session.WithServerSide())
+
+		request(h, "/set", nil)
+		request(h, "/get", []*http.Cookie{{Name: "leapkit", Value: "forged"}})
+		if *got != nil {
+			t.Errorf("Expected a new session, got %v", *got)
+		}
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := session.NewMemoryStore()
+
+	err := store.Save("abc", map[interface{}]interface{}{"user": "antonio"}, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := store.Load("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if values["user"] != "antonio" {
+		t.Errorf("Expected the stored values, got %v", values)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	values, err = store.Load("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if values != nil {
+		t.Errorf("Expected the values to expire, got %v", values)
+	}
+}
@@ -0,0 +1,31 @@
+package session
+
+import "github.com/gorilla/sessions"
+
+// Session keys the timestamps used by the timeouts are stored under.
+const (
+	createdAtKey  = "_created_at"
+	lastSeenAtKey = "_last_seen_at"
+)
+
+// verifyTimeouts invalidates the session when the idle or the absolute
+// timeout was exceeded, then records the activity of the current request.
+func (c *config) verifyTimeouts(session *sessions.Session) {
+	now := c.now().Unix()
+
+	createdAt, _ := session.Values[createdAtKey].(int64)
+	lastSeenAt, _ := session.Values[lastSeenAtKey].(int64)
+
+	idle := c.idleTimeout > 0 && lastSeenAt > 0 && now-lastSeenAt > int64(c.idleTimeout.Seconds())
+	expired := c.absoluteTimeout > 0 && createdAt > 0 && now-createdAt > int64(c.absoluteTimeout.Seconds())
+	if idle || expired {
+		c.invalidate(session)
+		createdAt = 0
+	}
+
+	if createdAt == 0 {
+		session.Values[createdAtKey] = now
+	}
+
+	session.Values[lastSeenAtKey] = now
+}
@@ -0,0 +1,97 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTimeouts(t *testing.T) {
+	// newHandler returns a handler that stores the user on /login and
+	// reports the stored user on other paths, using a mock clock.
+	newHandler := func(now *time.Time, options ...Option) (http.Handler, *interface{}) {
+		var user interface{}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+			FromCtx(r.Context()).Values["user"] = "antonio"
+			w.WriteHeader(http.StatusOK)
+		})
+
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			user = FromCtx(r.Context()).Values["user"]
+			w.WriteHeader(http.StatusOK)
+		})
+
+		clock := func(c *config) { c.now = func() time.Time { return *now } }
+		return Middleware("secret", "leapkit", append(options, clock)...)(mux), &user
+	}
+
+	// request performs a request to path with the passed
+	// cookies and returns the cookies of the response.
+	request := func(h http.Handler, path string, cookies []*http.Cookie) []*http.Cookie {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, req)
+
+		if len(res.Result().Cookies()) == 0 {
+			return cookies
+		}
+
+		return res.Result().Cookies()
+	}
+
+	t.Run("activity resets the idle timeout", func(t *testing.T) {
+		now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+		h, user := newHandler(&now, WithIdleTimeout(30*time.Minute))
+
+		cookies := request(h, "/login", nil)
+		for range 3 {
+			now = now.Add(20 * time.Minute)
+			cookies = request(h, "/", cookies)
+		}
+
+		if *user != "antonio" {
+			t.Errorf("Expected the session to be kept, got %v", *user)
+		}
+	})
+
+	t.Run("idle timeout invalidates the session", func(t *testing.T) {
+		now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+		h, user := newHandler(&now, WithIdleTimeout(30*time.Minute))
+
+		cookies := request(h, "/login", nil)
+		now = now.Add(31 * time.Minute)
+		request(h, "/", cookies)
+
+		if *user != nil {
+			t.Errorf("Expected the session to be invalidated, got %v", *user)
+		}
+	})
+
+	t.Run("absolute timeout invalidates an active session", func(t *testing.T) {
+		now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+		h, user := newHandler(&now, WithIdleTimeout(30*time.Minute), WithAbsoluteTimeout(time.Hour))
+
+		cookies := request(h, "/login", nil)
+		for range 2 {
+			now = now.Add(25 * time.Minute)
+			cookies = request(h, "/", cookies)
+		}
+
+		if *user != "antonio" {
+			t.Fatalf("Expected the session to be kept, got %v", *user)
+		}
+
+		now = now.Add(25 * time.Minute)
+		request(h, "/", cookies)
+
+		if *user != nil {
+			t.Errorf("Expected the session to be invalidated, got %v", *user)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package session
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+)
+
+// tokenPrefix is prepended to the purpose to build the key
+// the one-time tokens are stored under in the session.
+const tokenPrefix = "_token:"
+
+// IssueToken generates a random single-use token for the purpose, e.g.
+// "confirm-email", and stores it in the request session replacing any
+// previous token for the same purpose. The session gets saved along with
+// the response.
+//
+//	token := session.IssueToken(r, "confirm-email")
+func IssueToken(r *http.Request, purpose string) string {
+	bb := make([]byte, 32)
+	if _, err := rand.Read(bb); err != nil {
+		panic(err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(bb)
+
+	FromCtx(r.Context()).Values[tokenPrefix+purpose] = token
+
+	return token
+}
+
+// ConsumeToken reports whether the token matches the one issued for the
+// purpose. The stored token is removed on the first attempt, matching or
+// not, so it can't be reused or guessed in later requests.
+//
+// Single use is best-effort: each request loads its own copy of the
+// session, also with WithServerSide, so concurrent requests with the same
+// cookie can each consume the token. Flows that need a strict guarantee
+// should record the used tokens in a database.
+//
+//	if !session.ConsumeToken(r, "confirm-email", r.FormValue("token")) {
+//		http.Error(w, "invalid token", http.StatusBadRequest)
+//		return
+//	}
+func ConsumeToken(r *http.Request, purpose, token string) bool {
+	session := FromCtx(r.Context())
+
+	stored, ok := session.Values[tokenPrefix+purpose].(string)
+	if !ok {
+		return false
+	}
+
+	delete(session.Values, tokenPrefix+purpose)
+
+	return token != "" && subtle.ConstantTimeCompare([]byte(stored), []byte(token)) == 1
+}
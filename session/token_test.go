@@ -0,0 +1,77 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/leapkit/core/session"
+)
+
+func TestConsumeToken(t *testing.T) {
+	mw := session.Middleware("secret", "leapkit")
+
+	var token string
+	var results []bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/issue", func(w http.ResponseWriter, r *http.Request) {
+		token = session.IssueToken(r, "confirm-email")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux.HandleFunc("/consume", func(w http.ResponseWriter, r *http.Request) {
+		results = append(results, session.ConsumeToken(r, r.FormValue("purpose"), r.FormValue("token")))
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := mw(mux)
+
+	cookies := []*http.Cookie{}
+	do := func(path string) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		if len(res.Result().Cookies()) > 0 {
+			cookies = res.Result().Cookies()
+		}
+	}
+
+	t.Run("validates once", func(t *testing.T) {
+		results = nil
+		do("/issue")
+		if token == "" {
+			t.Fatal("Expected a token to be issued")
+		}
+
+		do("/consume?purpose=confirm-email&token=" + token)
+		do("/consume?purpose=confirm-email&token=" + token)
+
+		if !results[0] {
+			t.Error("Expected the token to validate the first time")
+		}
+
+		if results[1] {
+			t.Error("Expected the token to fail on reuse")
+		}
+	})
+
+	t.Run("fails with other token or purpose", func(t *testing.T) {
+		results = nil
+		do("/issue")
+		do("/consume?purpose=reset-password&token=" + token)
+		do("/consume?purpose=confirm-email&token=wrong")
+		do("/consume?purpose=confirm-email&token=" + token)
+
+		for i, ok := range results {
+			if ok {
+				t.Errorf("Expected attempt %d to fail", i)
+			}
+		}
+	})
+}